@@ -0,0 +1,8 @@
+import {
+  to = time_offset.example
+
+  identity = {
+    base_rfc3339 = "2020-02-12T06:36:13Z"
+    offset_days  = 7
+  }
+}
\ No newline at end of file
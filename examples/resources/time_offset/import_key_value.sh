@@ -0,0 +1 @@
+terraform import time_offset.example 'base=2020-02-12T06:36:13Z,offset_days=7,triggers.env=prod'
\ No newline at end of file
@@ -0,0 +1,10 @@
+# Keeps the timestamp recorded by time_static.example as the base timestamp
+# instead of replacing the resource. Requires Terraform 1.8 or later.
+moved {
+  from = time_static.example
+  to   = time_offset.example
+}
+
+resource "time_offset" "example" {
+  offset_days = 7
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import time_rotating.example '2020-02-12T06:36:13Z,0 3 * 1,4,7,10 MON#1'
@@ -0,0 +1 @@
+terraform import time_rotating.example 2020-02-12T06:36:13Z,P1M2DT3H
@@ -0,0 +1,8 @@
+import {
+  to = time_rotating.example
+
+  identity = {
+    rfc3339       = "2020-02-12T06:36:13Z"
+    rotation_days = 30
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import time_rotating.example 'base=2020-02-12T06:36:13Z,rotation_days=30,triggers.env=prod'
\ No newline at end of file
@@ -0,0 +1,10 @@
+# Keeps the timestamp recorded by time_static.example as the base timestamp
+# instead of replacing the resource. Requires Terraform 1.8 or later.
+moved {
+  from = time_static.example
+  to   = time_rotating.example
+}
+
+resource "time_rotating" "example" {
+  rotation_days = 30
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = time_sleep.example
+
+  identity = {
+    create_duration = "30s"
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import time_sleep.example 'create_duration=30s,triggers.env=prod'
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = time_static.example
+
+  identity = {
+    rfc3339 = "2020-02-12T06:36:13Z"
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import time_static.example 'base=2020-02-12T06:36:13Z,triggers.env=prod'
\ No newline at end of file
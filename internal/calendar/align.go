@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Unit is a calendar period that timestamps can be aligned to.
+type Unit string
+
+const (
+	Minute  Unit = "minute"
+	Hour    Unit = "hour"
+	Day     Unit = "day"
+	Week    Unit = "week"
+	Month   Unit = "month"
+	Quarter Unit = "quarter"
+	Year    Unit = "year"
+)
+
+// Units lists the supported calendar units, from shortest to longest.
+var Units = []Unit{Minute, Hour, Day, Week, Month, Quarter, Year}
+
+// ParseUnit returns the calendar unit with the given case-insensitive name.
+func ParseUnit(s string) (Unit, error) {
+	for _, unit := range Units {
+		if strings.EqualFold(s, string(unit)) {
+			return unit, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown calendar unit (%q)", s)
+}
+
+// ParseWeekday returns the weekday with the given case-insensitive English
+// name, e.g. "monday", or its three letter abbreviation, e.g. "MON".
+func ParseWeekday(s string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(s, day.String()) || strings.EqualFold(s, day.String()[:3]) {
+			return day, nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf("unknown weekday (%q)", s)
+}
+
+// Truncate returns the start of the calendar period containing t, evaluated
+// in the location of t. Weeks start on weekStart.
+func Truncate(t time.Time, unit Unit, weekStart time.Weekday) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+
+	switch unit {
+	case Minute:
+		return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, loc)
+	case Hour:
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, loc)
+	case Day:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case Week:
+		offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+	case Month:
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	case Quarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc)
+	case Year:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	}
+
+	return t
+}
+
+// Ceil returns t when it is already at the start of a calendar period,
+// otherwise the start of the following period. Weeks start on weekStart.
+func Ceil(t time.Time, unit Unit, weekStart time.Weekday) time.Time {
+	start := Truncate(t, unit, weekStart)
+
+	if start.Equal(t) {
+		return t
+	}
+
+	return next(start, unit)
+}
+
+// next returns the start of the period following the one starting at start.
+func next(start time.Time, unit Unit) time.Time {
+	year, month, day := start.Date()
+	loc := start.Location()
+
+	switch unit {
+	case Minute:
+		return start.Add(time.Minute)
+	case Hour:
+		return start.Add(time.Hour)
+	case Day:
+		return time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+	case Week:
+		return time.Date(year, month, day+7, 0, 0, 0, 0, loc)
+	case Month:
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+	case Quarter:
+		return time.Date(year, month+3, 1, 0, 0, 0, 0, loc)
+	case Year:
+		return time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+	}
+
+	return start
+}
+
+// TruncateDuration returns t rounded down to a multiple of d, counted from the
+// start of the day containing t in the location of t, so that e.g. 6h steps
+// are at 00:00, 06:00, 12:00 and 18:00 local time.
+func TruncateDuration(t time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return t
+	}
+
+	start := Truncate(t, Day, time.Monday)
+
+	return start.Add(t.Sub(start) / d * d)
+}
+
+// CeilDuration returns t when it is already a multiple of d counted from the
+// start of its day, otherwise the following multiple.
+func CeilDuration(t time.Time, d time.Duration) time.Time {
+	start := TruncateDuration(t, d)
+
+	if start.Equal(t) {
+		return t
+	}
+
+	return start.Add(d)
+}
+
+// Boundary is a calendar unit or an exact duration of at most a day that
+// timestamps are truncated or rounded to. Weeks start on Monday.
+type Boundary struct {
+	unit     Unit
+	duration time.Duration
+}
+
+// ParseBoundary parses a calendar unit name, e.g. "hour", or a positive Go or
+// ISO 8601 duration of at most a day without calendar parts, e.g. "15m".
+func ParseBoundary(s string) (Boundary, error) {
+	if unit, err := ParseUnit(s); err == nil {
+		return Boundary{unit: unit}, nil
+	}
+
+	period, err := ParsePeriod(s)
+	if err != nil {
+		return Boundary{}, fmt.Errorf("could not parse boundary (%q), expected a calendar unit or duration: %w", s, err)
+	}
+
+	if period.Years != 0 || period.Months != 0 || period.Days != 0 || period.Duration <= 0 || period.Duration > 24*time.Hour {
+		return Boundary{}, fmt.Errorf("boundary duration (%q) must be positive and at most 24h", s)
+	}
+
+	return Boundary{duration: period.Duration}, nil
+}
+
+// Truncate returns the last boundary at or before t, in the location of t.
+func (b Boundary) Truncate(t time.Time) time.Time {
+	if b.unit != "" {
+		return Truncate(t, b.unit, time.Monday)
+	}
+
+	return TruncateDuration(t, b.duration)
+}
+
+// Ceil returns the first boundary at or after t, in the location of t.
+func (b Boundary) Ceil(t time.Time) time.Time {
+	if b.unit != "" {
+		return Ceil(t, b.unit, time.Monday)
+	}
+
+	return CeilDuration(t, b.duration)
+}
+
+// Round returns the boundary closest to t, preferring the later one when
+// both are equally close.
+func (b Boundary) Round(t time.Time) time.Time {
+	floor, ceil := b.Truncate(t), b.Ceil(t)
+
+	if t.Sub(floor) < ceil.Sub(t) {
+		return floor
+	}
+
+	return ceil
+}
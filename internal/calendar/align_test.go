@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCeil(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unable to load test location: %s", err)
+	}
+
+	// 2030-02-17 is a Sunday.
+	timestamp := time.Date(2030, time.February, 17, 10, 30, 15, 0, time.UTC)
+
+	testCases := map[string]struct {
+		timestamp time.Time
+		unit      Unit
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		"minute": {
+			timestamp: timestamp,
+			unit:      Minute,
+			expected:  time.Date(2030, time.February, 17, 10, 31, 0, 0, time.UTC),
+		},
+		"hour": {
+			timestamp: timestamp,
+			unit:      Hour,
+			expected:  time.Date(2030, time.February, 17, 11, 0, 0, 0, time.UTC),
+		},
+		"day": {
+			timestamp: timestamp,
+			unit:      Day,
+			expected:  time.Date(2030, time.February, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"week-monday": {
+			timestamp: timestamp,
+			unit:      Week,
+			weekStart: time.Monday,
+			expected:  time.Date(2030, time.February, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"week-sunday": {
+			timestamp: timestamp,
+			unit:      Week,
+			weekStart: time.Sunday,
+			expected:  time.Date(2030, time.February, 24, 0, 0, 0, 0, time.UTC),
+		},
+		"month": {
+			timestamp: timestamp,
+			unit:      Month,
+			expected:  time.Date(2030, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"quarter": {
+			timestamp: timestamp,
+			unit:      Quarter,
+			expected:  time.Date(2030, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"quarter-december": {
+			timestamp: time.Date(2030, time.December, 17, 0, 0, 0, 0, time.UTC),
+			unit:      Quarter,
+			expected:  time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"year": {
+			timestamp: timestamp,
+			unit:      Year,
+			expected:  time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"on-boundary": {
+			timestamp: time.Date(2030, time.March, 1, 0, 0, 0, 0, time.UTC),
+			unit:      Month,
+			expected:  time.Date(2030, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"location": {
+			timestamp: time.Date(2030, time.March, 30, 12, 0, 0, 0, berlin),
+			unit:      Day,
+			// Daylight saving time starts on 2030-03-31 in Europe/Berlin.
+			expected: time.Date(2030, time.March, 31, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Ceil(testCase.timestamp, testCase.unit, testCase.weekStart)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCeilDuration(t *testing.T) {
+	t.Parallel()
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatalf("unable to load test location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		timestamp        time.Time
+		duration         time.Duration
+		expectedTruncate time.Time
+		expectedCeil     time.Time
+	}{
+		"quarter-hour": {
+			timestamp:        time.Date(2030, time.February, 17, 10, 31, 15, 0, time.UTC),
+			duration:         15 * time.Minute,
+			expectedTruncate: time.Date(2030, time.February, 17, 10, 30, 0, 0, time.UTC),
+			expectedCeil:     time.Date(2030, time.February, 17, 10, 45, 0, 0, time.UTC),
+		},
+		"on-boundary": {
+			timestamp:        time.Date(2030, time.February, 17, 12, 0, 0, 0, time.UTC),
+			duration:         6 * time.Hour,
+			expectedTruncate: time.Date(2030, time.February, 17, 12, 0, 0, 0, time.UTC),
+			expectedCeil:     time.Date(2030, time.February, 17, 12, 0, 0, 0, time.UTC),
+		},
+		"next-day": {
+			timestamp:        time.Date(2030, time.February, 17, 19, 0, 0, 0, time.UTC),
+			duration:         6 * time.Hour,
+			expectedTruncate: time.Date(2030, time.February, 17, 18, 0, 0, 0, time.UTC),
+			expectedCeil:     time.Date(2030, time.February, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"location": {
+			// Asia/Kolkata is UTC+05:30, so local hours are not UTC hours.
+			timestamp:        time.Date(2030, time.February, 17, 10, 20, 0, 0, kolkata),
+			duration:         time.Hour,
+			expectedTruncate: time.Date(2030, time.February, 17, 10, 0, 0, 0, kolkata),
+			expectedCeil:     time.Date(2030, time.February, 17, 11, 0, 0, 0, kolkata),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := TruncateDuration(testCase.timestamp, testCase.duration)
+
+			if !got.Equal(testCase.expectedTruncate) {
+				t.Errorf("expected truncated %s, got %s", testCase.expectedTruncate, got)
+			}
+
+			got = CeilDuration(testCase.timestamp, testCase.duration)
+
+			if !got.Equal(testCase.expectedCeil) {
+				t.Errorf("expected ceiling %s, got %s", testCase.expectedCeil, got)
+			}
+		})
+	}
+}
+
+func TestBoundaryRound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		boundary  string
+		timestamp time.Time
+		expected  time.Time
+	}{
+		"closer-to-floor": {
+			boundary:  "hour",
+			timestamp: time.Date(2030, time.February, 17, 10, 29, 59, 0, time.UTC),
+			expected:  time.Date(2030, time.February, 17, 10, 0, 0, 0, time.UTC),
+		},
+		"halfway": {
+			boundary:  "hour",
+			timestamp: time.Date(2030, time.February, 17, 10, 30, 0, 0, time.UTC),
+			expected:  time.Date(2030, time.February, 17, 11, 0, 0, 0, time.UTC),
+		},
+		"week": {
+			// 2030-02-15 is a Friday.
+			boundary:  "week",
+			timestamp: time.Date(2030, time.February, 15, 10, 0, 0, 0, time.UTC),
+			expected:  time.Date(2030, time.February, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"duration": {
+			boundary:  "PT15M",
+			timestamp: time.Date(2030, time.February, 17, 10, 37, 0, 0, time.UTC),
+			expected:  time.Date(2030, time.February, 17, 10, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			boundary, err := ParseBoundary(testCase.boundary)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := boundary.Round(testCase.timestamp)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseBoundary_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"fortnight", "-1h", "0s", "P1D", "25h"} {
+		if _, err := ParseBoundary(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]time.Weekday{
+		"MONDAY": time.Monday,
+		"sunday": time.Sunday,
+		"Sat":    time.Saturday,
+	}
+
+	for input, expected := range testCases {
+		got, err := ParseWeekday(input)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", input, err)
+			continue
+		}
+
+		if got != expected {
+			t.Errorf("expected %s for %q, got %s", expected, input, got)
+		}
+	}
+
+	if _, err := ParseWeekday("someday"); err == nil {
+		t.Error("expected error for unknown weekday")
+	}
+}
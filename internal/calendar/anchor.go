@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"time"
+)
+
+// Multiply returns the period with every component multiplied by n.
+func (p Period) Multiply(n int) Period {
+	return Period{
+		Years:    p.Years * n,
+		Months:   p.Months * n,
+		Days:     p.Days * n,
+		Duration: p.Duration * time.Duration(n),
+	}
+}
+
+// NextAnchored returns the first anchor + k*period after t, for any integer k,
+// so the anchor may be before or after t. Multiples of the period are added to
+// the anchor itself rather than to the previous occurrence, so the schedule
+// does not drift. The period must be positive.
+func NextAnchored(anchor time.Time, period Period, t time.Time) time.Time {
+	estimate := period.AddTo(anchor).Sub(anchor)
+
+	if estimate <= 0 {
+		return time.Time{}
+	}
+
+	k := int(t.Sub(anchor) / estimate)
+
+	for !period.Multiply(k).AddTo(anchor).After(t) {
+		k++
+	}
+
+	for period.Multiply(k - 1).AddTo(anchor).After(t) {
+		k--
+	}
+
+	return period.Multiply(k).AddTo(anchor)
+}
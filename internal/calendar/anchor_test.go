@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextAnchored(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unable to load test location: %s", err)
+	}
+
+	quarterly := Period{Months: 3}
+
+	testCases := map[string]struct {
+		anchor   time.Time
+		period   Period
+		t        time.Time
+		expected time.Time
+	}{
+		"anchor-in-past": {
+			anchor:   time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			period:   quarterly,
+			t:        time.Date(2030, time.May, 17, 10, 30, 0, 0, time.UTC),
+			expected: time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"anchor-long-ago": {
+			anchor:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			period:   quarterly,
+			t:        time.Date(2030, time.May, 17, 10, 30, 0, 0, time.UTC),
+			expected: time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"anchor-in-future": {
+			anchor:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			period:   quarterly,
+			t:        time.Date(2030, time.May, 17, 10, 30, 0, 0, time.UTC),
+			expected: time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"on-occurrence": {
+			anchor:   time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			period:   quarterly,
+			t:        time.Date(2030, time.April, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"duration": {
+			anchor:   time.Date(2030, time.January, 1, 0, 15, 0, 0, time.UTC),
+			period:   Period{Duration: time.Hour},
+			t:        time.Date(2030, time.May, 17, 10, 30, 0, 0, time.UTC),
+			expected: time.Date(2030, time.May, 17, 11, 15, 0, 0, time.UTC),
+		},
+		"location": {
+			anchor: time.Date(2030, time.January, 1, 3, 0, 0, 0, berlin),
+			period: Period{Days: 1},
+			// Daylight saving time starts on 2030-03-31 in Europe/Berlin.
+			t:        time.Date(2030, time.March, 31, 3, 30, 0, 0, berlin),
+			expected: time.Date(2030, time.April, 1, 3, 0, 0, 0, berlin),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NextAnchored(testCase.anchor, testCase.period, testCase.t)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
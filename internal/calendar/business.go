@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout of dates without a time, e.g. holidays.
+const DateLayout = "2006-01-02"
+
+// BusinessDays is a working week with weekend days and holidays that are
+// skipped when counting business days.
+type BusinessDays struct {
+	weekend  map[time.Weekday]bool
+	holidays map[string]bool
+}
+
+// NewBusinessDays returns business days that skip the weekend days and the
+// holidays, which are dates in YYYY-MM-DD format. A nil weekend defaults to
+// Saturday and Sunday.
+func NewBusinessDays(weekend []time.Weekday, holidays []string) (BusinessDays, error) {
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	b := BusinessDays{
+		weekend:  make(map[time.Weekday]bool, len(weekend)),
+		holidays: make(map[string]bool, len(holidays)),
+	}
+
+	for _, weekday := range weekend {
+		b.weekend[weekday] = true
+	}
+
+	if len(b.weekend) == 7 {
+		return BusinessDays{}, errors.New("at least one day of the week must not be a weekend day")
+	}
+
+	for _, holiday := range holidays {
+		date, err := ParseDate(holiday)
+		if err != nil {
+			return BusinessDays{}, err
+		}
+
+		b.holidays[date.Format(DateLayout)] = true
+	}
+
+	return b, nil
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(s string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse date (%q), expected YYYY-MM-DD: %w", s, err)
+	}
+
+	return date, nil
+}
+
+// IsBusinessDay reports whether the date of t, in the location of t, is
+// neither a weekend day nor a holiday.
+func (b BusinessDays) IsBusinessDay(t time.Time) bool {
+	return !b.weekend[t.Weekday()] && !b.holidays[t.Format(DateLayout)]
+}
+
+// Add returns t moved by n business days, forwards for a positive n and
+// backwards for a negative n, keeping the local time of day.
+func (b BusinessDays) Add(t time.Time, n int) time.Time {
+	step := 1
+
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+
+		if b.IsBusinessDay(t) {
+			n--
+		}
+	}
+
+	return t
+}
+
+// Next returns t if it is on a business day, otherwise the same local time
+// on the next business day.
+func (b BusinessDays) Next(t time.Time) time.Time {
+	for !b.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessDaysAdd(t *testing.T) {
+	t.Parallel()
+
+	weekend, err := NewBusinessDays(nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	holidays, err := NewBusinessDays(nil, []string{"2030-01-21"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	fridayWeekend, err := NewBusinessDays([]time.Weekday{time.Friday, time.Saturday}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 2030-01-17 is a Thursday.
+	timestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		businessDays BusinessDays
+		n            int
+		expected     time.Time
+	}{
+		"zero": {
+			businessDays: weekend,
+			n:            0,
+			expected:     timestamp,
+		},
+		"within-week": {
+			businessDays: weekend,
+			n:            1,
+			expected:     time.Date(2030, time.January, 18, 10, 0, 0, 0, time.UTC),
+		},
+		"over-weekend": {
+			businessDays: weekend,
+			n:            2,
+			expected:     time.Date(2030, time.January, 21, 10, 0, 0, 0, time.UTC),
+		},
+		"over-holiday": {
+			businessDays: holidays,
+			n:            2,
+			expected:     time.Date(2030, time.January, 22, 10, 0, 0, 0, time.UTC),
+		},
+		"custom-weekend": {
+			businessDays: fridayWeekend,
+			n:            1,
+			expected:     time.Date(2030, time.January, 20, 10, 0, 0, 0, time.UTC),
+		},
+		"backwards": {
+			businessDays: holidays,
+			n:            -3,
+			expected:     time.Date(2030, time.January, 14, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.businessDays.Add(timestamp, testCase.n)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysNext(t *testing.T) {
+	t.Parallel()
+
+	businessDays, err := NewBusinessDays(nil, []string{"2030-01-21"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 2030-01-19 is a Saturday and 2030-01-21 a holiday.
+	got := businessDays.Next(time.Date(2030, time.January, 19, 10, 0, 0, 0, time.UTC))
+	expected := time.Date(2030, time.January, 22, 10, 0, 0, 0, time.UTC)
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	friday := time.Date(2030, time.January, 18, 10, 0, 0, 0, time.UTC)
+
+	if got := businessDays.Next(friday); !got.Equal(friday) {
+		t.Errorf("expected %s, got %s", friday, got)
+	}
+}
+
+func TestNewBusinessDays_invalid(t *testing.T) {
+	t.Parallel()
+
+	allWeek := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+	if _, err := NewBusinessDays(allWeek, nil); err == nil {
+		t.Error("expected error for a weekend without business days")
+	}
+
+	if _, err := NewBusinessDays(nil, []string{"2030-13-01"}); err == nil {
+		t.Error("expected error for an invalid holiday")
+	}
+}
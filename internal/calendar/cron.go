@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+)
+
+const cronTimezonePrefix = "CRON_TZ="
+
+// CronSchedule is a parsed cron expression, optionally bound to a time zone
+// with a leading CRON_TZ= field.
+type CronSchedule struct {
+	expression *cronexpr.Expression
+	location   *time.Location
+}
+
+// ParseCron parses a standard five field cron expression (minute, hour, day of
+// month, month and day of week) or one of the predefined @ macros such as
+// @daily. The expression may be prefixed with CRON_TZ=<IANA time zone> to
+// evaluate the schedule in that time zone instead of the zone of the
+// timestamps passed to Next.
+func ParseCron(spec string) (*CronSchedule, error) {
+	fields := strings.Fields(spec)
+
+	var location *time.Location
+
+	if len(fields) > 0 && strings.HasPrefix(fields[0], cronTimezonePrefix) {
+		name := strings.TrimPrefix(fields[0], cronTimezonePrefix)
+
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("could not load CRON_TZ time zone (%q): %w", name, err)
+		}
+
+		location = loc
+		fields = fields[1:]
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("cron expression (%q) is empty", spec)
+	}
+
+	if !strings.HasPrefix(fields[0], "@") && len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression (%q) must have 5 fields (minute, hour, day of month, month, day of week), got %d", spec, len(fields))
+	}
+
+	if strings.HasPrefix(fields[0], "@") && len(fields) != 1 {
+		return nil, fmt.Errorf("cron expression (%q) must not have fields after the %s macro", spec, fields[0])
+	}
+
+	expression, err := cronexpr.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse cron expression (%q): %w", spec, err)
+	}
+
+	return &CronSchedule{
+		expression: expression,
+		location:   location,
+	}, nil
+}
+
+// Next returns the first occurrence of the schedule strictly after t, in the
+// location of t. The zero time is returned if the schedule never matches
+// again, for example "0 0 30 2 *".
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	from := t
+
+	if s.location != nil {
+		from = t.In(s.location)
+	}
+
+	next := s.expression.Next(from)
+
+	if next.IsZero() {
+		return next
+	}
+
+	return next.In(t.Location())
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		"every-day": {
+			spec:     "0 3 * * *",
+			from:     time.Date(2024, time.January, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.January, 18, 3, 0, 0, 0, time.UTC),
+		},
+		"strictly-after": {
+			spec:     "0 3 * * *",
+			from:     time.Date(2024, time.January, 17, 3, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.January, 18, 3, 0, 0, 0, time.UTC),
+		},
+		"first-monday-of-quarter": {
+			spec:     "0 3 * 1,4,7,10 MON#1",
+			from:     time.Date(2024, time.January, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.April, 1, 3, 0, 0, 0, time.UTC),
+		},
+		"macro": {
+			spec:     "@monthly",
+			from:     time.Date(2024, time.January, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"cron-tz": {
+			spec:     "CRON_TZ=Europe/Berlin 0 3 * * *",
+			from:     time.Date(2024, time.January, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.January, 18, 2, 0, 0, 0, time.UTC),
+		},
+		"cron-tz-summer-time": {
+			spec:     "CRON_TZ=Europe/Berlin 0 3 * * *",
+			from:     time.Date(2024, time.July, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, time.July, 18, 1, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			spec:     "0 0 30 2 *",
+			from:     time.Date(2024, time.January, 17, 10, 0, 0, 0, time.UTC),
+			expected: time.Time{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := ParseCron(testCase.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := schedule.Next(testCase.from)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if !got.IsZero() && got.Location() != testCase.from.Location() {
+				t.Errorf("expected location %s, got %s", testCase.from.Location(), got.Location())
+			}
+		})
+	}
+}
+
+func TestParseCron_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"empty":            "",
+		"too-few-fields":   "0 3 * *",
+		"seconds-field":    "0 0 3 * * *",
+		"invalid-field":    "61 3 * * *",
+		"invalid-timezone": "CRON_TZ=Mars/Olympus 0 3 * * *",
+		"only-timezone":    "CRON_TZ=UTC",
+		"macro-and-fields": "@daily 0",
+	}
+
+	for name, spec := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ParseCron(spec); err == nil {
+				t.Errorf("expected error for %q", spec)
+			}
+		})
+	}
+}
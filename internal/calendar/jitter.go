@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// Jitter returns a deterministic offset between zero and maxJitter inclusive,
+// derived from a hash of the seed. The offset is a whole number of seconds so
+// that it survives formatting as an RFC3339 timestamp.
+func Jitter(seed string, maxJitter time.Duration) time.Duration {
+	seconds := uint64(maxJitter / time.Second)
+
+	if seconds == 0 {
+		return 0
+	}
+
+	sum := sha256.Sum256([]byte(seed))
+
+	return time.Duration(binary.BigEndian.Uint64(sum[:8])%(seconds+1)) * time.Second
+}
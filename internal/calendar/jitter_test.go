@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+
+	maxJitter := 6 * time.Hour
+
+	if Jitter("workspace-a", maxJitter) != Jitter("workspace-a", maxJitter) {
+		t.Error("expected the same jitter for the same seed")
+	}
+
+	offsets := make(map[time.Duration]struct{})
+
+	for i := 0; i < 100; i++ {
+		offset := Jitter(fmt.Sprintf("workspace-%d", i), maxJitter)
+
+		if offset < 0 || offset > maxJitter {
+			t.Fatalf("expected jitter between 0 and %s, got %s", maxJitter, offset)
+		}
+
+		if offset%time.Second != 0 {
+			t.Fatalf("expected jitter in whole seconds, got %s", offset)
+		}
+
+		offsets[offset] = struct{}{}
+	}
+
+	if len(offsets) < 90 {
+		t.Errorf("expected jitter to be spread across seeds, got %d distinct offsets for 100 seeds", len(offsets))
+	}
+
+	if offset := Jitter("workspace-a", 500*time.Millisecond); offset != 0 {
+		t.Errorf("expected no jitter below one second, got %s", offset)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoPeriodRegexp matches an ISO 8601 duration such as P1Y2M3W4DT5H6M7.5S,
+// optionally preceded by a sign. Only the seconds may have a fraction.
+var isoPeriodRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Period is an amount of calendar time, made up of years, months and days
+// that are added in local time, plus an exact duration.
+type Period struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// ParsePeriod parses either a Go duration string, e.g. "36h", or an ISO 8601
+// duration, e.g. "P1M2DT3H". ISO 8601 weeks are converted to days.
+func ParsePeriod(s string) (Period, error) {
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		return parseISOPeriod(s)
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return Period{}, fmt.Errorf("could not parse duration (%q) as a Go or ISO 8601 duration: %w", s, err)
+	}
+
+	return Period{Duration: duration}, nil
+}
+
+func parseISOPeriod(s string) (Period, error) {
+	matches := isoPeriodRegexp.FindStringSubmatch(s)
+
+	if matches == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return Period{}, fmt.Errorf("could not parse duration (%q) as an ISO 8601 duration, e.g. P1M2DT3H", s)
+	}
+
+	var values [6]int
+
+	for i, match := range matches[2:8] {
+		if match == "" {
+			continue
+		}
+
+		value, err := strconv.Atoi(match)
+		if err != nil {
+			return Period{}, fmt.Errorf("could not parse duration (%q) as an ISO 8601 duration: %w", s, err)
+		}
+
+		values[i] = value
+	}
+
+	var seconds float64
+
+	if matches[8] != "" {
+		value, err := strconv.ParseFloat(strings.Replace(matches[8], ",", ".", 1), 64)
+		if err != nil {
+			return Period{}, fmt.Errorf("could not parse duration (%q) as an ISO 8601 duration: %w", s, err)
+		}
+
+		seconds = value
+	}
+
+	period := Period{
+		Years:  values[0],
+		Months: values[1],
+		Days:   values[2]*7 + values[3],
+		Duration: time.Duration(values[4])*time.Hour +
+			time.Duration(values[5])*time.Minute +
+			time.Duration(math.Round(seconds*float64(time.Second))),
+	}
+
+	if matches[1] == "-" {
+		period = period.Negate()
+	}
+
+	return period, nil
+}
+
+// IsZero reports whether the period adds no time.
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0 && p.Duration == 0
+}
+
+// IsNegative reports whether the period moves timestamps backwards.
+func (p Period) IsNegative() bool {
+	return p.Years < 0 || p.Months < 0 || p.Days < 0 || p.Duration < 0
+}
+
+// Negate returns the period with the sign of every component reversed.
+func (p Period) Negate() Period {
+	return Period{
+		Years:    -p.Years,
+		Months:   -p.Months,
+		Days:     -p.Days,
+		Duration: -p.Duration,
+	}
+}
+
+// AddTo returns t with the years, months and days of the period added in the
+// location of t, followed by the exact duration.
+func (p Period) AddTo(t time.Time) time.Time {
+	return t.AddDate(p.Years, p.Months, p.Days).Add(p.Duration)
+}
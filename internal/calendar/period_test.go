@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected Period
+	}{
+		"go-hours": {
+			input:    "36h",
+			expected: Period{Duration: 36 * time.Hour},
+		},
+		"go-mixed": {
+			input:    "1h30m15s",
+			expected: Period{Duration: time.Hour + 30*time.Minute + 15*time.Second},
+		},
+		"go-negative": {
+			input:    "-90m",
+			expected: Period{Duration: -90 * time.Minute},
+		},
+		"iso-date-and-time": {
+			input:    "P1M2DT3H",
+			expected: Period{Months: 1, Days: 2, Duration: 3 * time.Hour},
+		},
+		"iso-all": {
+			input:    "P1Y2M3DT4H5M6S",
+			expected: Period{Years: 1, Months: 2, Days: 3, Duration: 4*time.Hour + 5*time.Minute + 6*time.Second},
+		},
+		"iso-weeks": {
+			input:    "P2W",
+			expected: Period{Days: 14},
+		},
+		"iso-time-only": {
+			input:    "PT36H",
+			expected: Period{Duration: 36 * time.Hour},
+		},
+		"iso-fractional-seconds": {
+			input:    "PT1.5S",
+			expected: Period{Duration: 1500 * time.Millisecond},
+		},
+		"iso-negative": {
+			input:    "-P1D",
+			expected: Period{Days: -1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePeriod(testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %+v, got %+v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestParsePeriod_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"empty":               "",
+		"no-unit":             "36",
+		"iso-empty":           "P",
+		"iso-empty-time":      "P1DT",
+		"iso-wrong-order":     "P1D2M",
+		"iso-time-without-t":  "P1H",
+		"iso-fractional-days": "P1.5D",
+		"unknown-unit":        "3x",
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ParsePeriod(input); err == nil {
+				t.Errorf("expected error for %q", input)
+			}
+		})
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unable to load test location: %s", err)
+	}
+
+	period := Period{Months: 1, Days: 2, Duration: 3 * time.Hour}
+
+	got := period.AddTo(time.Date(2030, time.March, 1, 0, 0, 0, 0, berlin))
+	expected := time.Date(2030, time.April, 3, 3, 0, 0, 0, berlin)
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Window is a recurring period of time, e.g. a maintenance window, that opens
+// at the same local time on each of its days of the week.
+type Window struct {
+	Days     []time.Weekday
+	Hour     int
+	Minute   int
+	Duration Period
+}
+
+// ParseTimeOfDay parses a time of day in HH:MM format.
+func ParseTimeOfDay(s string) (int, int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse time of day (%q), expected HH:MM: %w", s, err)
+	}
+
+	return t.Hour(), t.Minute(), nil
+}
+
+// Contains reports whether t falls into an opening of the window. The window
+// opens in the location of t, so that it stays at the same local time across
+// daylight saving time changes. Openings that started on a previous day are
+// taken into account when the duration spans midnight.
+func (w Window) Contains(t time.Time) bool {
+	if w.Duration.IsZero() || w.Duration.IsNegative() {
+		return false
+	}
+
+	for i := 0; ; i++ {
+		start := time.Date(t.Year(), t.Month(), t.Day()-i, w.Hour, w.Minute, 0, 0, t.Location())
+		end := w.Duration.AddTo(start)
+
+		if !end.After(t) {
+			return false
+		}
+
+		if w.opensOn(start.Weekday()) && !t.Before(start) {
+			return true
+		}
+	}
+}
+
+func (w Window) opensOn(weekday time.Weekday) bool {
+	for _, day := range w.Days {
+		if day == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowContains(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unable to load test location: %s", err)
+	}
+
+	// Saturday and Sunday from 22:00 for four hours.
+	window := Window{
+		Days:     []time.Weekday{time.Saturday, time.Sunday},
+		Hour:     22,
+		Duration: Period{Duration: 4 * time.Hour},
+	}
+
+	// 2030-01-19 is a Saturday.
+	testCases := map[string]struct {
+		window   Window
+		t        time.Time
+		expected bool
+	}{
+		"before-opening": {
+			window:   window,
+			t:        time.Date(2030, time.January, 19, 21, 59, 0, 0, time.UTC),
+			expected: false,
+		},
+		"at-opening": {
+			window:   window,
+			t:        time.Date(2030, time.January, 19, 22, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		"after-midnight": {
+			window:   window,
+			t:        time.Date(2030, time.January, 21, 1, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		"at-closing": {
+			window:   window,
+			t:        time.Date(2030, time.January, 20, 2, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		"other-day": {
+			window:   window,
+			t:        time.Date(2030, time.January, 18, 23, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		"multiple-days": {
+			window: Window{
+				Days:     []time.Weekday{time.Friday},
+				Hour:     18,
+				Duration: Period{Days: 2, Duration: 12 * time.Hour},
+			},
+			t:        time.Date(2030, time.January, 21, 5, 59, 0, 0, time.UTC),
+			expected: true,
+		},
+		"location": {
+			window:   window,
+			t:        time.Date(2030, time.January, 19, 22, 30, 0, 0, berlin),
+			expected: true,
+		},
+		"location-utc": {
+			window: window,
+			// 22:30 in Europe/Berlin, but the window opens at 22:00 UTC.
+			t:        time.Date(2030, time.January, 19, 21, 30, 0, 0, time.UTC),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.window.Contains(testCase.t)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t for %s, got %t", testCase.expected, testCase.t, got)
+			}
+		})
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	hour, minute, err := ParseTimeOfDay("22:30")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hour != 22 || minute != 30 {
+		t.Errorf("expected 22:30, got %02d:%02d", hour, minute)
+	}
+
+	for _, input := range []string{"24:00", "9:30pm", "2230"} {
+		if _, _, err := ParseTimeOfDay(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timemodifier
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-time/internal/clock"
+)
+
+// Outdated reports whether the current time of the clock has passed the
+// rotation timestamp by more than the skew tolerance. The tolerance keeps
+// plan and apply, or machines with slightly different clocks, from
+// disagreeing whether a rotation is due right at the rotation timestamp.
+//
+// Resources call Outdated from ModifyPlan rather than from an attribute plan
+// modifier, since schema plan modifiers are created before the resource is
+// configured with the provider clock.
+func Outdated(clock clock.Clock, rotationTimestamp time.Time, skew time.Duration) bool {
+	return clock.Now().After(rotationTimestamp.Add(skew))
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timemodifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-time/internal/timetesting"
+)
+
+func TestOutdated(t *testing.T) {
+	t.Parallel()
+
+	rotationTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		now      time.Time
+		skew     time.Duration
+		expected bool
+	}{
+		"before-rotation": {
+			now:      rotationTimestamp.Add(-time.Second),
+			expected: false,
+		},
+		"at-rotation": {
+			now:      rotationTimestamp,
+			expected: false,
+		},
+		"after-rotation": {
+			now:      rotationTimestamp.Add(time.Second),
+			expected: true,
+		},
+		"within-skew": {
+			now:      rotationTimestamp.Add(time.Minute),
+			skew:     5 * time.Minute,
+			expected: false,
+		},
+		"after-skew": {
+			now:      rotationTimestamp.Add(5*time.Minute + time.Second),
+			skew:     5 * time.Minute,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Outdated(timetesting.NewFakeClock(testCase.now), rotationTimestamp, testCase.skew)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setIdentity sets the resource identity from the current values. The
+// identity includes values that can change, such as the rotation arguments
+// of time_rotating, so Read and Update always set it, which also covers
+// resources created before identity support was added.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, value any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, value)
+}
+
+// importIDPart formats an optional identity attribute as part of a
+// comma-separated import ID, where null values are empty.
+func importIDPart(value types.Int64) string {
+	if value.IsNull() {
+		return ""
+	}
+
+	return strconv.FormatInt(value.ValueInt64(), 10)
+}
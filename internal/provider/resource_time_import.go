@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importTriggersKeyPrefix prefixes the import ID keys that set a triggers
+// map element, e.g. triggers.env=prod.
+const importTriggersKeyPrefix = "triggers."
+
+// importIDCommaKeys are the keys whose values may contain commas, in
+// addition to the triggers keys.
+var importIDCommaKeys = []string{"rotation_cron"}
+
+// importIDKeyRegexp matches the keys of the key=value import ID syntax.
+// Uppercase keys are not matched, so cron expressions with a CRON_TZ= prefix
+// are not mistaken for a key.
+var importIDKeyRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*(\..+)?$`)
+
+// isKeyValueImportID reports whether the import ID uses the key=value syntax
+// rather than a positional comma-separated format. None of the positional
+// formats has an equals sign in the first part.
+func isKeyValueImportID(id string) bool {
+	firstPart, _, _ := strings.Cut(id, ",")
+	key, _, ok := strings.Cut(firstPart, "=")
+
+	return ok && importIDKeyRegexp.MatchString(key)
+}
+
+// parseKeyValueImportID parses an import ID in the key=value syntax, e.g.
+// base=2024-01-01T00:00:00Z,rotation_days=30,triggers.env=prod, into the
+// values of the given keys and the triggers map. A part without a key
+// continues the previous value if that is a triggers value or one of
+// importIDCommaKeys, so that cron expressions may contain commas, and is an
+// error otherwise. The returned triggers are null if no triggers keys are
+// given, matching a configuration without the triggers argument.
+func parseKeyValueImportID(ctx context.Context, id string, keys []string) (map[string]string, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var pairKeys []string
+	pairs := make(map[string]string)
+
+	for _, part := range strings.Split(id, ",") {
+		key, value, ok := strings.Cut(part, "=")
+
+		if !ok || !importIDKeyRegexp.MatchString(key) {
+			if len(pairKeys) == 0 {
+				diags.AddError(
+					"Unexpected Format of ID",
+					fmt.Sprintf("Unexpected format of ID (%q), expected KEY=VALUE pairs separated by commas", id))
+
+				return nil, types.MapNull(types.StringType), diags
+			}
+
+			lastKey := pairKeys[len(pairKeys)-1]
+
+			if !strings.HasPrefix(lastKey, importTriggersKeyPrefix) && !slices.Contains(importIDCommaKeys, lastKey) {
+				diags.AddError(
+					"Unexpected Format of ID",
+					fmt.Sprintf("Unexpected format of ID (%q), %q is not a KEY=VALUE pair and the %s value cannot contain commas", id, part, lastKey))
+
+				return nil, types.MapNull(types.StringType), diags
+			}
+
+			pairs[lastKey] += "," + part
+
+			continue
+		}
+
+		if _, ok := pairs[key]; ok {
+			diags.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), the %s key is given more than once", id, key))
+
+			return nil, types.MapNull(types.StringType), diags
+		}
+
+		pairKeys = append(pairKeys, key)
+		pairs[key] = value
+	}
+
+	values := make(map[string]string)
+	var triggers map[string]string
+
+	for _, key := range pairKeys {
+		if name, ok := strings.CutPrefix(key, importTriggersKeyPrefix); ok {
+			if triggers == nil {
+				triggers = make(map[string]string)
+			}
+
+			triggers[name] = pairs[key]
+
+			continue
+		}
+
+		if !slices.Contains(keys, key) {
+			diags.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected key %q in ID (%q), expected one of %s or %sNAME", key, id, strings.Join(keys, ", "), importTriggersKeyPrefix))
+
+			return nil, types.MapNull(types.StringType), diags
+		}
+
+		values[key] = pairs[key]
+	}
+
+	if triggers == nil {
+		return values, types.MapNull(types.StringType), diags
+	}
+
+	triggersValue, mapDiags := types.MapValueFrom(ctx, types.StringType, triggers)
+
+	diags.Append(mapDiags...)
+
+	return values, triggersValue, diags
+}
+
+// keyValueImportIDParts parses an import ID in the key=value syntax and
+// returns the values of the given keys in order, where missing keys are
+// empty, along with the triggers map.
+func keyValueImportIDParts(ctx context.Context, id string, keys []string) ([]string, types.Map, diag.Diagnostics) {
+	values, triggers, diags := parseKeyValueImportID(ctx, id, keys)
+	if diags.HasError() {
+		return nil, triggers, diags
+	}
+
+	parts := make([]string, len(keys))
+
+	for i, key := range keys {
+		parts[i] = values[key]
+	}
+
+	return parts, triggers, diags
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfaddr "github.com/hashicorp/terraform-registry-address"
+)
+
+// isTimeProviderAddress reports whether the source provider address is this
+// provider. Only the namespace and type are compared, so the provider may be
+// installed from any registry host or mirror.
+func isTimeProviderAddress(address string) bool {
+	provider, err := tfaddr.ParseProviderSource(address)
+	if err != nil {
+		return false
+	}
+
+	return provider.Namespace == "hashicorp" && provider.Type == "time"
+}
+
+// timeMoveSourceBaseAttributes maps the time resources that can be moved to
+// another time resource to the attribute holding their base timestamp.
+var timeMoveSourceBaseAttributes = map[string]string{
+	"time_offset":   "base_rfc3339",
+	"time_rotating": "rfc3339",
+	"time_static":   "rfc3339",
+}
+
+// timeMoveSourceState is the subset of the source state that is kept when
+// moving between time resources. The raw state is decoded directly, as the
+// attributes are the same across all schema versions of the source resources.
+type timeMoveSourceState struct {
+	BaseRFC3339 string            `json:"base_rfc3339"`
+	RFC3339     string            `json:"rfc3339"`
+	Triggers    map[string]string `json:"triggers"`
+}
+
+// moveTimeSourceState returns the base timestamp and triggers of the moved
+// time resource. The returned bool is false if the source resource is not
+// one of the time resources, in which case the move is left to other state
+// movers.
+func moveTimeSourceState(ctx context.Context, req resource.MoveStateRequest) (time.Time, types.Map, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baseAttribute, ok := timeMoveSourceBaseAttributes[req.SourceTypeName]
+
+	if !isTimeProviderAddress(req.SourceProviderAddress) || !ok {
+		return time.Time{}, types.MapNull(types.StringType), false, diags
+	}
+
+	if req.SourceRawState == nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The source %s state is missing.", req.SourceTypeName),
+		)
+		return time.Time{}, types.MapNull(types.StringType), true, diags
+	}
+
+	var sourceState timeMoveSourceState
+
+	if err := json.Unmarshal(req.SourceRawState.JSON, &sourceState); err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The source %s state could not be decoded.\n\nOriginal Error: %s", req.SourceTypeName, err),
+		)
+		return time.Time{}, types.MapNull(types.StringType), true, diags
+	}
+
+	baseRFC3339 := sourceState.RFC3339
+
+	if baseAttribute == "base_rfc3339" {
+		baseRFC3339 = sourceState.BaseRFC3339
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, baseRFC3339)
+	if err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The source %s %s value (%q) could not be parsed as RFC3339.\n\nOriginal Error: %s", req.SourceTypeName, baseAttribute, baseRFC3339, err),
+		)
+		return time.Time{}, types.MapNull(types.StringType), true, diags
+	}
+
+	// Null triggers are kept null, otherwise the triggers plan modifier would
+	// require a replacement of the target resource.
+	if sourceState.Triggers == nil {
+		return timestamp, types.MapNull(types.StringType), true, diags
+	}
+
+	triggers, mapDiags := types.MapValueFrom(ctx, types.StringType, sourceState.Triggers)
+
+	diags.Append(mapDiags...)
+
+	return timestamp, triggers, true, diags
+}
@@ -11,16 +11,22 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
 	"github.com/hashicorp/terraform-provider-time/internal/clock"
+	"github.com/hashicorp/terraform-provider-time/internal/validators/timevalidator"
 )
 
 var (
@@ -29,6 +35,9 @@ var (
 	_ resource.ResourceWithModifyPlan       = (*timeOffsetResource)(nil)
 	_ resource.ResourceWithConfigValidators = (*timeOffsetResource)(nil)
 	_ resource.ResourceWithConfigure        = (*timeOffsetResource)(nil)
+	_ resource.ResourceWithMoveState        = (*timeOffsetResource)(nil)
+	_ resource.ResourceWithIdentity         = (*timeOffsetResource)(nil)
+	_ resource.ResourceWithUpgradeState     = (*timeOffsetResource)(nil)
 )
 
 func NewTimeOffsetResource() resource.Resource {
@@ -62,14 +71,34 @@ func (t *timeOffsetResource) Configure(ctx context.Context, req resource.Configu
 
 func (t *timeOffsetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_offset"
+
+	// The identity includes arguments that can be updated in place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Description: "Manages an offset time resource, which keeps an UTC timestamp stored in the Terraform state that is" +
 			" offset from a locally sourced base timestamp. This prevents perpetual differences caused " +
 			"by using the [`timestamp()` function](https://www.terraform.io/docs/configuration/functions/timestamp.html).",
 		Attributes: map[string]schema.Attribute{
+			"align_to_cron": schema.StringAttribute{
+				Description: "Cron expression that the offset timestamp is moved forward to the next occurrence of, after " +
+					"the 'offset_' arguments are applied. An offset timestamp that is already an occurrence is kept. The " +
+					"expression uses the standard five fields (minute, hour, day of month, month, day of week) and is " +
+					"evaluated in the configured `timezone`, unless prefixed with `CRON_TZ=<time zone>`, e.g. `0 2 * * SUN`. " +
+					"Conflicts with `truncate_to` and `round_to`.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Cron(),
+				},
+			},
+			"age_seconds": schema.Int64Attribute{
+				Description: "Number of seconds since the `base_rfc3339` timestamp, refreshed on every read, or " +
+					"negative while the timestamp is in the future. Only set when `track_age` is `true`.",
+				Computed: true,
+			},
 			"base_rfc3339": schema.StringAttribute{
 				CustomType: timetypes.RFC3339Type{},
 				Description: "Base timestamp in " +
@@ -83,6 +112,15 @@ func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Number day of offset timestamp.",
 				Computed:    true,
 			},
+			"holidays": schema.ListAttribute{
+				Description: "Dates in `YYYY-MM-DD` format, e.g. `2030-12-25`, that are not business days. Dates are in the " +
+					"configured `timezone`, or in UTC when `timezone` is not configured.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(timevalidator.Date()),
+				},
+			},
 			"hour": schema.Int64Attribute{
 				Description: "Number hour of offset timestamp.",
 				Computed:    true,
@@ -96,6 +134,36 @@ func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequ
 					mapplanmodifier.RequiresReplace(),
 				},
 			},
+			"local_day": schema.Int64Attribute{
+				Description: "Number day of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_hour": schema.Int64Attribute{
+				Description: "Number hour of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_minute": schema.Int64Attribute{
+				Description: "Number minute of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_month": schema.Int64Attribute{
+				Description: "Number month of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Description: "RFC3339 format of the offset timestamp with the offset of the configured `timezone`, e.g. " +
+					"`2020-02-12T07:36:13+01:00`, or in UTC when `timezone` is not configured.",
+				Computed: true,
+			},
+			"local_second": schema.Int64Attribute{
+				Description: "Number second of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_year": schema.Int64Attribute{
+				Description: "Number year of offset timestamp in the configured `timezone`, or of the UTC timestamp when `timezone` is not configured.",
+				Computed:    true,
+			},
 			"minute": schema.Int64Attribute{
 				Description: "Number minute of offset timestamp.",
 				Computed:    true,
@@ -104,10 +172,25 @@ func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Number month of offset timestamp.",
 				Computed:    true,
 			},
+			"offset_business_days": schema.Int64Attribute{
+				Description: "Number of business days to offset the base timestamp, after any other 'offset_' arguments. " +
+					"Weekend days and holidays are skipped, see `weekend_days` and `holidays`. A negative number offsets " +
+					"backwards. At least one of the 'offset_' arguments must be configured.",
+				Optional: true,
+			},
 			"offset_days": schema.Int64Attribute{
 				Description: "Number of days to offset the base timestamp. At least one of the 'offset_' arguments must be configured.",
 				Optional:    true,
 			},
+			"offset_duration": schema.StringAttribute{
+				Description: "Signed Go duration, e.g. `-90m`, or ISO 8601 duration, e.g. `P1Y2M10DT2H30M`, to offset the " +
+					"base timestamp, added after the other 'offset_' arguments except `offset_business_days`. Years, months and days are added as calendar " +
+					"units and the time parts as an exact duration. At least one of the 'offset_' arguments must be configured.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Duration(),
+				},
+			},
 			"offset_hours": schema.Int64Attribute{
 				Description: " Number of hours to offset the base timestamp. At least one of the 'offset_' arguments must be configured.",
 				Optional:    true,
@@ -133,14 +216,60 @@ func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "RFC3339 format of the offset timestamp, e.g. `2020-02-12T06:36:13Z`.",
 				Computed:    true,
 			},
+			"round_to": schema.StringAttribute{
+				Description: "Calendar unit, one of `minute`, `hour`, `day`, `week`, `month`, `quarter` or `year`, or positive " +
+					"Go or ISO 8601 duration of at most a day, e.g. `15m`, to round the offset timestamp to the nearest boundary of, rounding up when halfway. " +
+					"Applied after the 'offset_' arguments, in the configured `timezone`. Weeks start on Monday and durations " +
+					"are counted from the start of the day. Conflicts with `truncate_to`.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Boundary(),
+				},
+			},
 			"second": schema.Int64Attribute{
 				Description: "Number second of offset timestamp.",
 				Computed:    true,
 			},
+			"timezone": schema.StringAttribute{
+				Description: "[IANA time zone](https://www.iana.org/time-zones) name, e.g. `Europe/Berlin`, used to calculate the " +
+					"offset timestamp. Years, months, days and business days are added in local time, so the offset timestamp " +
+					"stays at the same local time across daylight saving time changes. The `rfc3339`, `unix`, `year`, `month`, " +
+					"`day`, `hour`, `minute` and `second` attributes are unchanged, see the 'local_' attributes for local values.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Timezone(),
+				},
+			},
+			"track_age": schema.BoolAttribute{
+				Description: "Whether to set `age_seconds`, which is refreshed on every read.",
+				Optional:    true,
+			},
+			"truncate_to": schema.StringAttribute{
+				Description: "Calendar unit, one of `minute`, `hour`, `day`, `week`, `month`, `quarter` or `year`, or positive " +
+					"Go or ISO 8601 duration of at most a day, e.g. `15m`, to truncate the offset timestamp to the start of. " +
+					"Applied after the 'offset_' arguments, in the configured `timezone`. Weeks start on Monday and durations " +
+					"are counted from the start of the day. Conflicts with `round_to`.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Boundary(),
+				},
+			},
 			"unix": schema.Int64Attribute{
 				Description: "Number of seconds since epoch time, e.g. `1581489373`.",
 				Computed:    true,
 			},
+			"weekend_days": schema.ListAttribute{
+				Description: "Days of the week that are not business days, e.g. `[\"FRIDAY\", \"SATURDAY\"]`. Defaults to " +
+					"Saturday and Sunday. When `offset_business_days`, `weekend_days` or `holidays` is configured, an offset " +
+					"timestamp on a weekend day or holiday is moved to the same time on the next business day.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(6),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(timevalidator.Weekday()),
+				},
+			},
 			"year": schema.Int64Attribute{
 				Description: "Number year of offset timestamp.",
 				Computed:    true,
@@ -157,6 +286,8 @@ func (t *timeOffsetResource) Schema(ctx context.Context, req resource.SchemaRequ
 func (t *timeOffsetResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("offset_business_days"),
+			path.MatchRoot("offset_duration"),
 			path.MatchRoot("offset_seconds"),
 			path.MatchRoot("offset_minutes"),
 			path.MatchRoot("offset_hours"),
@@ -164,6 +295,18 @@ func (t *timeOffsetResource) ConfigValidators(_ context.Context) []resource.Conf
 			path.MatchRoot("offset_months"),
 			path.MatchRoot("offset_years"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("truncate_to"),
+			path.MatchRoot("round_to"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("align_to_cron"),
+			path.MatchRoot("truncate_to"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("align_to_cron"),
+			path.MatchRoot("round_to"),
+		),
 	}
 }
 
@@ -179,7 +322,7 @@ func (t *timeOffsetResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	var state, plan timeOffsetModelV0
+	var state, plan timeOffsetModelV1
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -198,7 +341,16 @@ func (t *timeOffsetResource) ModifyPlan(ctx context.Context, req resource.Modify
 		state.OffsetDays == plan.OffsetDays &&
 		state.OffsetHours == plan.OffsetHours &&
 		state.OffsetMinutes == plan.OffsetMinutes &&
-		state.OffsetSeconds == plan.OffsetSeconds {
+		state.OffsetSeconds == plan.OffsetSeconds &&
+		state.OffsetDuration == plan.OffsetDuration &&
+		state.OffsetBusinessDays == plan.OffsetBusinessDays &&
+		state.WeekendDays.Equal(plan.WeekendDays) &&
+		state.Holidays.Equal(plan.Holidays) &&
+		state.Timezone == plan.Timezone &&
+		state.TruncateTo == plan.TruncateTo &&
+		state.RoundTo == plan.RoundTo &&
+		state.AlignToCron == plan.AlignToCron &&
+		state.TrackAge == plan.TrackAge {
 		return
 	}
 
@@ -229,32 +381,114 @@ func (t *timeOffsetResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	setOffsetValues(&plan, timestamp)
+	resp.Diagnostics.Append(setOffsetValues(&plan, timestamp)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The age is only known once applied, as it depends on the time of the
+	// apply.
+	plan.AgeSeconds = types.Int64Null()
+
+	if plan.TrackAge.ValueBool() {
+		plan.AgeSeconds = types.Int64Unknown()
+	}
 
 	diags = resp.Plan.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+func (t *timeOffsetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"base_rfc3339": identityschema.StringAttribute{
+				Description:       "Base timestamp in RFC3339 format, e.g. `2020-02-12T06:36:13Z`.",
+				RequiredForImport: true,
+			},
+			"offset_days": identityschema.Int64Attribute{
+				Description:       "Number of days to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_duration": identityschema.StringAttribute{
+				Description:       "Go or ISO 8601 duration to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_hours": identityschema.Int64Attribute{
+				Description:       "Number of hours to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_minutes": identityschema.Int64Attribute{
+				Description:       "Number of minutes to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_months": identityschema.Int64Attribute{
+				Description:       "Number of months to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_seconds": identityschema.Int64Attribute{
+				Description:       "Number of seconds to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+			"offset_years": identityschema.Int64Attribute{
+				Description:       "Number of years to offset the base timestamp.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
 func (t *timeOffsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var importedState timeOffsetModelV0
+	var importedState timeOffsetModelV1
 	var err error
 
 	id := req.ID
 
+	if id == "" && req.Identity != nil {
+		var identity timeOffsetIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id = identity.importID()
+	}
+
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if isKeyValueImportID(id) {
+		parts, keyValueTriggers, diags := keyValueImportIDParts(ctx, id, timeOffsetImportKeys)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if parts[0] == "" || strings.Join(parts[1:], "") == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), expected base=BASETIMESTAMP and at least one of %s", id, strings.Join(timeOffsetImportKeys[1:], ", ")))
+
+			return
+		}
+
+		id, triggers = strings.Join(parts, ","), keyValueTriggers
+	}
+
 	idParts := strings.Split(id, ",")
 
-	if len(idParts) != 7 {
+	if len(idParts) != 7 && len(idParts) != 8 {
 		resp.Diagnostics.AddError(
 			"Unexpected Format of ID",
-			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES,SECONDS", id))
+			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES,SECONDS[,DURATION]", id))
 
 		return
 	}
 
-	if idParts[0] == "" || (idParts[1] == "" && idParts[2] == "" && idParts[3] == "" && idParts[4] == "" && idParts[5] == "" && idParts[6] == "") {
+	if idParts[0] == "" || strings.Join(idParts[1:], "") == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Format of ID",
-			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES,SECONDS where at least one offset value is non-empty", id))
+			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES,SECONDS[,DURATION] where at least one offset value is non-empty", id))
 
 		return
 	}
@@ -321,6 +555,22 @@ func (t *timeOffsetResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
+	importedState.OffsetDuration = types.StringNull()
+
+	if len(idParts) == 8 && idParts[7] != "" {
+		_, err = calendar.ParsePeriod(idParts[7])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Import time offset error",
+				"The offset_duration parameter that was supplied could not be parsed as a Go or ISO 8601 duration.\n\n+"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		importedState.OffsetDuration = types.StringValue(idParts[7])
+	}
+
 	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -331,15 +581,85 @@ func (t *timeOffsetResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	setOffsetValues(&importedState, timestamp)
-	importedState.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	resp.Diagnostics.Append(setOffsetValues(&importedState, timestamp)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importedState.Triggers = triggers
+	importedState.OffsetBusinessDays = types.Int64Null()
+	importedState.WeekendDays = types.ListNull(types.StringType)
+	importedState.Holidays = types.ListNull(types.StringType)
+	importedState.Timezone = types.StringNull()
+	importedState.TruncateTo = types.StringNull()
+	importedState.RoundTo = types.StringNull()
+	importedState.AlignToCron = types.StringNull()
+	importedState.TrackAge = types.BoolNull()
+	importedState.AgeSeconds = types.Int64Null()
 
 	diags := resp.State.Set(ctx, importedState)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeOffsetIdentity(importedState))...)
+	}
+}
+
+// MoveState keeps the base timestamp and triggers of a time_rotating or
+// time_static resource that is moved to time_offset. The offset arguments are
+// left null so that the following plan calculates the offset timestamp as an
+// update.
+func (t *timeOffsetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				timestamp, triggers, ok, diags := moveTimeSourceState(ctx, req)
+
+				resp.Diagnostics.Append(diags...)
+
+				if !ok || resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := timeOffsetModelV1{
+					Triggers:           triggers,
+					OffsetYears:        types.Int64Null(),
+					OffsetMonths:       types.Int64Null(),
+					OffsetDays:         types.Int64Null(),
+					OffsetHours:        types.Int64Null(),
+					OffsetMinutes:      types.Int64Null(),
+					OffsetSeconds:      types.Int64Null(),
+					OffsetDuration:     types.StringNull(),
+					OffsetBusinessDays: types.Int64Null(),
+					WeekendDays:        types.ListNull(types.StringType),
+					Holidays:           types.ListNull(types.StringType),
+					Timezone:           types.StringNull(),
+					TruncateTo:         types.StringNull(),
+					RoundTo:            types.StringNull(),
+					AlignToCron:        types.StringNull(),
+					TrackAge:           types.BoolNull(),
+					AgeSeconds:         types.Int64Null(),
+				}
+
+				resp.Diagnostics.Append(setOffsetValues(&state, timestamp)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, newTimeOffsetIdentity(state))...)
+				}
+			},
+		},
+	}
 }
 
 func (t *timeOffsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan timeOffsetModelV0
+	var plan timeOffsetModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -361,17 +681,44 @@ func (t *timeOffsetResource) Create(ctx context.Context, req resource.CreateRequ
 		timestamp = baseRFC3339
 	}
 
-	setOffsetValues(&plan, timestamp)
+	resp.Diagnostics.Append(setOffsetValues(&plan, timestamp)...)
+	resp.Diagnostics.Append(setOffsetAge(&plan, t.clock.Now())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeOffsetIdentity(plan))...)
+	}
 }
 
 func (t *timeOffsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state timeOffsetModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TrackAge.ValueBool() {
+		resp.Diagnostics.Append(setOffsetAge(&state, t.clock.Now())...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeOffsetIdentity(state))...)
 }
 
 func (t *timeOffsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan timeOffsetModelV0
+	var plan timeOffsetModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -387,38 +734,133 @@ func (t *timeOffsetResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	setOffsetValues(&plan, timestamp)
+	resp.Diagnostics.Append(setOffsetValues(&plan, timestamp)...)
+	resp.Diagnostics.Append(setOffsetAge(&plan, t.clock.Now())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeOffsetIdentity(plan))...)
 }
 
 func (t *timeOffsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 
 }
 
-type timeOffsetModelV0 struct {
-	BaseRFC3339   timetypes.RFC3339 `tfsdk:"base_rfc3339"`
-	Triggers      types.Map         `tfsdk:"triggers"`
-	Year          types.Int64       `tfsdk:"year"`
-	Month         types.Int64       `tfsdk:"month"`
-	Day           types.Int64       `tfsdk:"day"`
-	Hour          types.Int64       `tfsdk:"hour"`
-	Minute        types.Int64       `tfsdk:"minute"`
-	Second        types.Int64       `tfsdk:"second"`
-	OffsetYears   types.Int64       `tfsdk:"offset_years"`
-	OffsetMonths  types.Int64       `tfsdk:"offset_months"`
-	OffsetDays    types.Int64       `tfsdk:"offset_days"`
-	OffsetHours   types.Int64       `tfsdk:"offset_hours"`
-	OffsetMinutes types.Int64       `tfsdk:"offset_minutes"`
-	OffsetSeconds types.Int64       `tfsdk:"offset_seconds"`
-	RFC3339       timetypes.RFC3339 `tfsdk:"rfc3339"`
-	Unix          types.Int64       `tfsdk:"unix"`
-	ID            timetypes.RFC3339 `tfsdk:"id"`
+type timeOffsetModelV1 struct {
+	BaseRFC3339        timetypes.RFC3339 `tfsdk:"base_rfc3339"`
+	Triggers           types.Map         `tfsdk:"triggers"`
+	Year               types.Int64       `tfsdk:"year"`
+	Month              types.Int64       `tfsdk:"month"`
+	Day                types.Int64       `tfsdk:"day"`
+	Hour               types.Int64       `tfsdk:"hour"`
+	Minute             types.Int64       `tfsdk:"minute"`
+	Second             types.Int64       `tfsdk:"second"`
+	OffsetYears        types.Int64       `tfsdk:"offset_years"`
+	OffsetMonths       types.Int64       `tfsdk:"offset_months"`
+	OffsetDays         types.Int64       `tfsdk:"offset_days"`
+	OffsetHours        types.Int64       `tfsdk:"offset_hours"`
+	OffsetMinutes      types.Int64       `tfsdk:"offset_minutes"`
+	OffsetSeconds      types.Int64       `tfsdk:"offset_seconds"`
+	OffsetDuration     types.String      `tfsdk:"offset_duration"`
+	OffsetBusinessDays types.Int64       `tfsdk:"offset_business_days"`
+	WeekendDays        types.List        `tfsdk:"weekend_days"`
+	Holidays           types.List        `tfsdk:"holidays"`
+	Timezone           types.String      `tfsdk:"timezone"`
+	TruncateTo         types.String      `tfsdk:"truncate_to"`
+	RoundTo            types.String      `tfsdk:"round_to"`
+	AlignToCron        types.String      `tfsdk:"align_to_cron"`
+	LocalYear          types.Int64       `tfsdk:"local_year"`
+	LocalMonth         types.Int64       `tfsdk:"local_month"`
+	LocalDay           types.Int64       `tfsdk:"local_day"`
+	LocalHour          types.Int64       `tfsdk:"local_hour"`
+	LocalMinute        types.Int64       `tfsdk:"local_minute"`
+	LocalSecond        types.Int64       `tfsdk:"local_second"`
+	LocalRFC3339       timetypes.RFC3339 `tfsdk:"local_rfc3339"`
+	RFC3339            timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Unix               types.Int64       `tfsdk:"unix"`
+	ID                 timetypes.RFC3339 `tfsdk:"id"`
+	TrackAge           types.Bool        `tfsdk:"track_age"`
+	AgeSeconds         types.Int64       `tfsdk:"age_seconds"`
+}
+
+// timeOffsetImportKeys are the keys of the key=value import ID syntax, in the
+// order of the positional import ID.
+var timeOffsetImportKeys = []string{
+	"base",
+	"offset_years",
+	"offset_months",
+	"offset_days",
+	"offset_hours",
+	"offset_minutes",
+	"offset_seconds",
+	"offset_duration",
+}
+
+type timeOffsetIdentityModel struct {
+	BaseRFC3339    types.String `tfsdk:"base_rfc3339"`
+	OffsetYears    types.Int64  `tfsdk:"offset_years"`
+	OffsetMonths   types.Int64  `tfsdk:"offset_months"`
+	OffsetDays     types.Int64  `tfsdk:"offset_days"`
+	OffsetHours    types.Int64  `tfsdk:"offset_hours"`
+	OffsetMinutes  types.Int64  `tfsdk:"offset_minutes"`
+	OffsetSeconds  types.Int64  `tfsdk:"offset_seconds"`
+	OffsetDuration types.String `tfsdk:"offset_duration"`
+}
+
+func newTimeOffsetIdentity(state timeOffsetModelV1) timeOffsetIdentityModel {
+	return timeOffsetIdentityModel{
+		BaseRFC3339:    types.StringValue(state.BaseRFC3339.ValueString()),
+		OffsetYears:    state.OffsetYears,
+		OffsetMonths:   state.OffsetMonths,
+		OffsetDays:     state.OffsetDays,
+		OffsetHours:    state.OffsetHours,
+		OffsetMinutes:  state.OffsetMinutes,
+		OffsetSeconds:  state.OffsetSeconds,
+		OffsetDuration: state.OffsetDuration,
+	}
+}
+
+// importID returns the identity in the BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES,SECONDS[,DURATION]
+// import ID format, so that both are validated the same way.
+func (m timeOffsetIdentityModel) importID() string {
+	idParts := []string{
+		m.BaseRFC3339.ValueString(),
+		importIDPart(m.OffsetYears),
+		importIDPart(m.OffsetMonths),
+		importIDPart(m.OffsetDays),
+		importIDPart(m.OffsetHours),
+		importIDPart(m.OffsetMinutes),
+		importIDPart(m.OffsetSeconds),
+	}
+
+	if m.OffsetDuration.ValueString() != "" {
+		idParts = append(idParts, m.OffsetDuration.ValueString())
+	}
+
+	return strings.Join(idParts, ",")
 }
 
-func setOffsetValues(plan *timeOffsetModelV0, timestamp time.Time) {
+func setOffsetValues(plan *timeOffsetModelV1, timestamp time.Time) diag.Diagnostics {
+	location, diags := loadTimezone(plan.Timezone)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Calendar arithmetic is done in the configured time zone so that adding
+	// days, months or years keeps the same local time across daylight saving
+	// time changes.
 	var offsetTimestamp = timestamp
 
+	if location != nil {
+		offsetTimestamp = timestamp.In(location)
+	}
+
 	if plan.OffsetDays.ValueInt64() != 0 {
 		offsetTimestamp = offsetTimestamp.AddDate(0, 0, int(plan.OffsetDays.ValueInt64()))
 	}
@@ -446,6 +888,100 @@ func setOffsetValues(plan *timeOffsetModelV0, timestamp time.Time) {
 		offsetTimestamp = offsetTimestamp.AddDate(int(plan.OffsetYears.ValueInt64()), 0, 0)
 	}
 
+	if plan.OffsetDuration.ValueString() != "" {
+		offsetDuration, err := calendar.ParsePeriod(plan.OffsetDuration.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("offset_duration"),
+				"Invalid Duration",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return diags
+		}
+
+		offsetTimestamp = offsetDuration.AddTo(offsetTimestamp)
+	}
+
+	if !plan.OffsetBusinessDays.IsNull() || !plan.WeekendDays.IsNull() || !plan.Holidays.IsNull() {
+		businessDays, businessDaysDiags := parseBusinessDays(plan.WeekendDays, plan.Holidays)
+
+		diags.Append(businessDaysDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if plan.OffsetBusinessDays.ValueInt64() != 0 {
+			offsetTimestamp = businessDays.Add(offsetTimestamp, int(plan.OffsetBusinessDays.ValueInt64()))
+		}
+
+		offsetTimestamp = businessDays.Next(offsetTimestamp)
+	}
+
+	if plan.TruncateTo.ValueString() != "" {
+		boundary, err := calendar.ParseBoundary(plan.TruncateTo.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("truncate_to"),
+				"Invalid Boundary",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return diags
+		}
+
+		offsetTimestamp = boundary.Truncate(offsetTimestamp)
+	}
+
+	if plan.RoundTo.ValueString() != "" {
+		boundary, err := calendar.ParseBoundary(plan.RoundTo.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("round_to"),
+				"Invalid Boundary",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return diags
+		}
+
+		offsetTimestamp = boundary.Round(offsetTimestamp)
+	}
+
+	if plan.AlignToCron.ValueString() != "" {
+		schedule, err := calendar.ParseCron(plan.AlignToCron.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("align_to_cron"),
+				"Invalid Cron Expression",
+				"The align_to_cron expression that was supplied could not be parsed.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return diags
+		}
+
+		// Next is strictly after its argument, so an offset timestamp that is
+		// already an occurrence is kept.
+		next := schedule.Next(offsetTimestamp.Add(-time.Nanosecond))
+
+		if next.IsZero() {
+			diags.AddAttributeError(
+				path.Root("align_to_cron"),
+				"Invalid Cron Expression",
+				fmt.Sprintf("The align_to_cron expression (%q) has no occurrence after %s.", plan.AlignToCron.ValueString(), offsetTimestamp.Format(time.RFC3339)),
+			)
+			return diags
+		}
+
+		offsetTimestamp = next
+	}
+
+	localTimestamp := offsetTimestamp.UTC()
+
+	if location != nil {
+		localTimestamp = offsetTimestamp
+	}
+
+	offsetTimestamp = offsetTimestamp.In(timestamp.Location())
+
 	plan.BaseRFC3339 = timetypes.NewRFC3339TimeValue(timestamp)
 	plan.Year = types.Int64Value(int64(offsetTimestamp.Year()))
 	plan.Month = types.Int64Value(int64(offsetTimestamp.Month()))
@@ -456,6 +992,35 @@ func setOffsetValues(plan *timeOffsetModelV0, timestamp time.Time) {
 	plan.RFC3339 = timetypes.NewRFC3339TimeValue(offsetTimestamp)
 	plan.Unix = types.Int64Value(offsetTimestamp.Unix())
 	plan.ID = timetypes.NewRFC3339TimeValue(timestamp)
+	plan.LocalYear = types.Int64Value(int64(localTimestamp.Year()))
+	plan.LocalMonth = types.Int64Value(int64(localTimestamp.Month()))
+	plan.LocalDay = types.Int64Value(int64(localTimestamp.Day()))
+	plan.LocalHour = types.Int64Value(int64(localTimestamp.Hour()))
+	plan.LocalMinute = types.Int64Value(int64(localTimestamp.Minute()))
+	plan.LocalSecond = types.Int64Value(int64(localTimestamp.Second()))
+	plan.LocalRFC3339 = timetypes.NewRFC3339TimeValue(localTimestamp)
+
+	return diags
+}
+
+// setOffsetAge sets age_seconds from the base timestamp if track_age is
+// enabled.
+func setOffsetAge(plan *timeOffsetModelV1, now time.Time) diag.Diagnostics {
+	plan.AgeSeconds = types.Int64Null()
+
+	if !plan.TrackAge.ValueBool() || plan.BaseRFC3339.ValueString() == "" {
+		return nil
+	}
+
+	timestamp, diags := plan.BaseRFC3339.ValueRFC3339Time()
+
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.AgeSeconds = types.Int64Value(int64(now.Sub(timestamp) / time.Second))
+
+	return diags
 }
 
 func offsetToInt64(offsetStr string) (types.Int64, error) {
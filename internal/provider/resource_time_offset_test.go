@@ -11,9 +11,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-time/internal/timetesting"
 )
 
 func TestAccTimeOffset_Triggers(t *testing.T) {
@@ -416,6 +420,517 @@ func TestAccTimeOffset_OffsetYearsAndMonths(t *testing.T) {
 	})
 }
 
+func TestAccTimeOffset_Identity(t *testing.T) {
+	resourceName := "time_offset.test"
+	timestamp := time.Now().UTC()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity is only available in Terraform v1.12.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetOffsetDaysEmptyTriggers(timestamp.Format(time.RFC3339), 7),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						"base_rfc3339":   knownvalue.StringExact(timestamp.Format(time.RFC3339)),
+						"offset_days":    knownvalue.Int64Exact(7),
+						"offset_hours":   knownvalue.Null(),
+						"offset_minutes": knownvalue.Null(),
+						"offset_months":  knownvalue.Null(),
+						"offset_seconds": knownvalue.Null(),
+						"offset_years":   knownvalue.Null(),
+					}),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_ImportKeyValue(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetTriggers1("key1", "value1"),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("base=%s,offset_days=1,triggers.key1=value1", rs.Primary.Attributes["base_rfc3339"]), nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "base=2024-01-01T00:00:00Z,offset_weeks=1",
+				ExpectError:   regexp.MustCompile(`Unexpected key "offset_weeks"`),
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_OffsetDuration(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetOffsetDuration("2030-03-30T10:00:00Z", "P1M2DT3H"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_rfc3339"), knownvalue.StringExact("2030-03-30T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("offset_duration"), knownvalue.StringExact("P1M2DT3H")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-05-02T13:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(time.Date(2030, 5, 2, 13, 0, 0, 0, time.UTC).Unix())),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimeOffsetImportStateIdFunc(),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigTimeOffsetOffsetDuration("2030-03-30T10:00:00Z", "-90m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("offset_duration"), knownvalue.StringExact("-90m")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-30T08:30:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_OffsetDuration_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigTimeOffsetOffsetDuration("2030-03-30T10:00:00Z", "1 day"),
+				ExpectError: regexp.MustCompile(`Invalid Duration`),
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_OffsetBusinessDays(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	// 2030-01-04 is a Friday.
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339         = "2030-01-04T10:00:00Z"
+  offset_business_days = 10
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(time.Date(2030, time.January, 18, 10, 0, 0, 0, time.UTC).Unix())),
+				},
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339         = "2030-01-04T10:00:00Z"
+  offset_business_days = 10
+  holidays             = ["2030-01-14"]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-21T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-21T10:00:00Z")),
+				},
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339         = "2030-01-04T10:00:00Z"
+  offset_business_days = 10
+  weekend_days         = ["FRIDAY", "SATURDAY"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+				},
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339         = "2030-01-04T10:00:00Z"
+  offset_business_days = -1
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-03T10:00:00Z")),
+				},
+			},
+			// An offset timestamp on a weekend day is moved to the next business day.
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339 = "2030-01-04T10:00:00Z"
+  offset_days  = 1
+  weekend_days = ["SATURDAY", "SUNDAY"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-07T10:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_Timezone(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	// Daylight saving time starts in Europe/Berlin on 2030-03-31.
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetOffsetDaysTimezone("2030-03-30T07:00:00Z", 1, "Europe/Berlin"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-31T06:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(6)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_rfc3339"), knownvalue.StringExact("2030-03-31T08:00:00+02:00")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_year"), knownvalue.Int64Exact(2030)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_month"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_day"), knownvalue.Int64Exact(31)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_hour"), knownvalue.Int64Exact(8)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_minute"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_second"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(time.Date(2030, time.March, 31, 6, 0, 0, 0, time.UTC).Unix())),
+				},
+			},
+			{
+				Config: testAccConfigTimeOffsetOffsetDays("2030-03-30T07:00:00Z", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-31T07:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_rfc3339"), knownvalue.StringExact("2030-03-31T07:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_hour"), knownvalue.Int64Exact(7)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_Timezone_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigTimeOffsetOffsetDaysTimezone("2030-03-30T07:00:00Z", 1, "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile(`.*Invalid Time Zone`),
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_TruncateTo(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339 = "2030-03-30T07:17:45Z"
+  offset_hours = 1
+  truncate_to  = "hour"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-30T08:00:00Z")),
+				},
+			},
+			// Truncation is in the configured time zone.
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339 = "2030-03-30T07:17:45Z"
+  offset_hours = 1
+  timezone     = "Europe/Berlin"
+  truncate_to  = "day"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-29T23:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-29T23:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_rfc3339"), knownvalue.StringExact("2030-03-30T00:00:00+01:00")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_RoundTo(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339 = "2030-03-30T07:17:45Z"
+  offset_hours = 1
+  round_to     = "15m"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-30T08:15:00Z")),
+				},
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339 = "2030-03-30T07:17:45Z"
+  offset_hours = 1
+  round_to     = "month"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-04-01T00:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_RoundTo_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "time_offset" "test" {
+  offset_hours = 1
+  round_to     = "fortnight"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Boundary`),
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  offset_hours = 1
+  round_to     = "hour"
+  truncate_to  = "hour"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_AlignToCron(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	// 2030-03-24 and 2030-03-31 are Sundays.
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetAlignToCron("2030-03-24T10:00:00Z", "0 2 * * SUN"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-04-07T02:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(time.Date(2030, time.April, 7, 2, 0, 0, 0, time.UTC).Unix())),
+				},
+			},
+			// An offset timestamp that is already an occurrence is kept.
+			{
+				Config: testAccConfigTimeOffsetAlignToCron("2030-03-24T02:00:00Z", "0 2 * * SUN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-31T02:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-03-31T02:00:00Z")),
+				},
+			},
+			// The schedule is evaluated in the configured time zone.
+			{
+				Config: `
+resource "time_offset" "test" {
+  base_rfc3339  = "2030-03-24T10:00:00Z"
+  offset_days   = 7
+  timezone      = "Europe/Berlin"
+  align_to_cron = "0 2 * * SUN"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-04-07T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_rfc3339"), knownvalue.StringExact("2030-04-07T02:00:00+02:00")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_AlignToCron_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigTimeOffsetAlignToCron("2030-03-24T10:00:00Z", "0 2 * *"),
+				ExpectError: regexp.MustCompile(`Invalid Cron Expression`),
+			},
+			{
+				Config: `
+resource "time_offset" "test" {
+  offset_days   = 7
+  align_to_cron = "0 2 * * SUN"
+  truncate_to   = "day"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_TrackAge(t *testing.T) {
+	resourceName := "time_offset.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			// The age is measured from the base timestamp, not the offset timestamp.
+			{
+				Config: testAccConfigTimeOffsetOffsetDaysTrackAge(1, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(0)),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(36 * time.Hour)
+				},
+				Config: testAccConfigTimeOffsetOffsetDaysTrackAge(1, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(36*60*60)),
+				},
+			},
+			// Changing the offset keeps the base timestamp and its age.
+			{
+				Config: testAccConfigTimeOffsetOffsetDaysTrackAge(3, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-20T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(36*60*60)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeOffset_MoveStateFromTimeStatic(t *testing.T) {
+	resourceName := "time_offset.test"
+	timestamp := time.Now().UTC()
+	offsetTimestamp := timestamp.AddDate(0, 0, 7)
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Moving state between resource types is only available in Terraform v1.8.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticRfc3339(timestamp.Format(time.RFC3339)),
+			},
+			{
+				Config: testAccConfigTimeOffsetMovedFromTimeStatic(7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_rfc3339"), knownvalue.StringExact(timestamp.Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("offset_days"), knownvalue.Int64Exact(7)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(offsetTimestamp.Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("triggers"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
 func TestAccTimeOffset_Upgrade(t *testing.T) {
 	resourceName := "time_offset.test"
 	timestamp := time.Now().UTC()
@@ -497,7 +1012,13 @@ func testAccTimeOffsetImportStateIdFunc() resource.ImportStateIdFunc {
 		offsetMinutes := rs.Primary.Attributes["offset_minutes"]
 		offsetSeconds := rs.Primary.Attributes["offset_seconds"]
 
-		return fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s", rs.Primary.ID, offsetYears, offsetMonths, offsetDays, offsetHours, offsetMinutes, offsetSeconds), nil
+		id := fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s", rs.Primary.ID, offsetYears, offsetMonths, offsetDays, offsetHours, offsetMinutes, offsetSeconds)
+
+		if offsetDuration := rs.Primary.Attributes["offset_duration"]; offsetDuration != "" {
+			id += "," + offsetDuration
+		}
+
+		return id, nil
 	}
 }
 
@@ -575,3 +1096,64 @@ resource "time_offset" "test" {
 }
 `, baseRfc3339, offsetYears, offsetMonths)
 }
+
+func testAccConfigTimeOffsetAlignToCron(baseRfc3339 string, alignToCron string) string {
+	return fmt.Sprintf(`
+resource "time_offset" "test" {
+  base_rfc3339  = %[1]q
+  offset_days   = 7
+  align_to_cron = %[2]q
+}
+`, baseRfc3339, alignToCron)
+}
+
+func testAccConfigTimeOffsetOffsetDaysTimezone(baseRfc3339 string, offsetDays int, timezone string) string {
+	return fmt.Sprintf(`
+resource "time_offset" "test" {
+  base_rfc3339 = %[1]q
+  offset_days  = %[2]d
+  timezone     = %[3]q
+}
+`, baseRfc3339, offsetDays, timezone)
+}
+
+func testAccConfigTimeOffsetOffsetDuration(baseRfc3339 string, offsetDuration string) string {
+	return fmt.Sprintf(`
+resource "time_offset" "test" {
+  base_rfc3339    = %[1]q
+  offset_duration = %[2]q
+}
+`, baseRfc3339, offsetDuration)
+}
+
+func testAccConfigTimeOffsetMovedFromTimeStatic(offsetDays int) string {
+	return fmt.Sprintf(`
+moved {
+  from = time_static.test
+  to   = time_offset.test
+}
+
+resource "time_offset" "test" {
+  offset_days = %[1]d
+}
+`, offsetDays)
+}
+
+func testAccConfigTimeOffsetOffsetDaysEmptyTriggers(baseRfc3339 string, offsetDays int) string {
+	return fmt.Sprintf(`
+resource "time_offset" "test" {
+  base_rfc3339 = %[1]q
+  offset_days  = %[2]d
+  triggers     = {}
+}
+`, baseRfc3339, offsetDays)
+}
+
+func testAccConfigTimeOffsetOffsetDaysTrackAge(offsetDays int, trackAge bool) string {
+	return fmt.Sprintf(`
+resource "time_offset" "test" {
+  offset_days = %[1]d
+  track_age   = %[2]t
+}
+`, offsetDays, trackAge)
+}
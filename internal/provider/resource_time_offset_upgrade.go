@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t *timeOffsetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := timeOffsetSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeTimeOffsetStateV0toV1,
+		},
+	}
+}
+
+// upgradeTimeOffsetStateV0toV1 adds the attributes introduced since version
+// 0, such as offset_duration and timezone, as null. Without a time zone the
+// local_ components are the UTC components of the saved offset timestamp, so
+// they are known without a plan or recalculating the offset timestamp.
+func upgradeTimeOffsetStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var stateV0 timeOffsetModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateV1 := timeOffsetModelV1{
+		BaseRFC3339:        stateV0.BaseRFC3339,
+		Triggers:           stateV0.Triggers,
+		Year:               stateV0.Year,
+		Month:              stateV0.Month,
+		Day:                stateV0.Day,
+		Hour:               stateV0.Hour,
+		Minute:             stateV0.Minute,
+		Second:             stateV0.Second,
+		OffsetYears:        stateV0.OffsetYears,
+		OffsetMonths:       stateV0.OffsetMonths,
+		OffsetDays:         stateV0.OffsetDays,
+		OffsetHours:        stateV0.OffsetHours,
+		OffsetMinutes:      stateV0.OffsetMinutes,
+		OffsetSeconds:      stateV0.OffsetSeconds,
+		OffsetDuration:     types.StringNull(),
+		OffsetBusinessDays: types.Int64Null(),
+		WeekendDays:        types.ListNull(types.StringType),
+		Holidays:           types.ListNull(types.StringType),
+		Timezone:           types.StringNull(),
+		TruncateTo:         types.StringNull(),
+		RoundTo:            types.StringNull(),
+		AlignToCron:        types.StringNull(),
+		LocalYear:          types.Int64Null(),
+		LocalMonth:         types.Int64Null(),
+		LocalDay:           types.Int64Null(),
+		LocalHour:          types.Int64Null(),
+		LocalMinute:        types.Int64Null(),
+		LocalSecond:        types.Int64Null(),
+		LocalRFC3339:       timetypes.NewRFC3339Null(),
+		RFC3339:            stateV0.RFC3339,
+		Unix:               stateV0.Unix,
+		ID:                 stateV0.ID,
+		TrackAge:           types.BoolNull(),
+		AgeSeconds:         types.Int64Null(),
+	}
+
+	if stateV0.RFC3339.ValueString() != "" {
+		timestamp, diags := stateV0.RFC3339.ValueRFC3339Time()
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		localTimestamp := timestamp.UTC()
+
+		stateV1.LocalYear = types.Int64Value(int64(localTimestamp.Year()))
+		stateV1.LocalMonth = types.Int64Value(int64(localTimestamp.Month()))
+		stateV1.LocalDay = types.Int64Value(int64(localTimestamp.Day()))
+		stateV1.LocalHour = types.Int64Value(int64(localTimestamp.Hour()))
+		stateV1.LocalMinute = types.Int64Value(int64(localTimestamp.Minute()))
+		stateV1.LocalSecond = types.Int64Value(int64(localTimestamp.Second()))
+		stateV1.LocalRFC3339 = timetypes.NewRFC3339TimeValue(localTimestamp)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateV1)...)
+}
+
+// timeOffsetSchemaV0 is the schema of version 0, as released up to provider
+// 0.14, without descriptions and plan modifiers.
+func timeOffsetSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"day":  schema.Int64Attribute{Computed: true},
+			"hour": schema.Int64Attribute{Computed: true},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"minute":         schema.Int64Attribute{Computed: true},
+			"month":          schema.Int64Attribute{Computed: true},
+			"offset_days":    schema.Int64Attribute{Optional: true},
+			"offset_hours":   schema.Int64Attribute{Optional: true},
+			"offset_minutes": schema.Int64Attribute{Optional: true},
+			"offset_months":  schema.Int64Attribute{Optional: true},
+			"offset_seconds": schema.Int64Attribute{Optional: true},
+			"offset_years":   schema.Int64Attribute{Optional: true},
+			"rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"second": schema.Int64Attribute{Computed: true},
+			"unix":   schema.Int64Attribute{Computed: true},
+			"year":   schema.Int64Attribute{Computed: true},
+			"id": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+type timeOffsetModelV0 struct {
+	BaseRFC3339   timetypes.RFC3339 `tfsdk:"base_rfc3339"`
+	Triggers      types.Map         `tfsdk:"triggers"`
+	Year          types.Int64       `tfsdk:"year"`
+	Month         types.Int64       `tfsdk:"month"`
+	Day           types.Int64       `tfsdk:"day"`
+	Hour          types.Int64       `tfsdk:"hour"`
+	Minute        types.Int64       `tfsdk:"minute"`
+	Second        types.Int64       `tfsdk:"second"`
+	OffsetYears   types.Int64       `tfsdk:"offset_years"`
+	OffsetMonths  types.Int64       `tfsdk:"offset_months"`
+	OffsetDays    types.Int64       `tfsdk:"offset_days"`
+	OffsetHours   types.Int64       `tfsdk:"offset_hours"`
+	OffsetMinutes types.Int64       `tfsdk:"offset_minutes"`
+	OffsetSeconds types.Int64       `tfsdk:"offset_seconds"`
+	RFC3339       timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Unix          types.Int64       `tfsdk:"unix"`
+	ID            timetypes.RFC3339 `tfsdk:"id"`
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeOffsetUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	// Attributes of version 0, as released up to provider 0.14, with a base
+	// timestamp that has a non-UTC offset.
+	stateV0 := `{
+"base_rfc3339": "2030-01-17T10:00:00+02:00",
+"day": 18,
+"hour": 10,
+"id": "2030-01-17T10:00:00+02:00",
+"minute": 0,
+"month": 1,
+"offset_days": 1,
+"offset_hours": null,
+"offset_minutes": null,
+"offset_months": null,
+"offset_seconds": null,
+"offset_years": null,
+"rfc3339": "2030-01-18T10:00:00+02:00",
+"second": 0,
+"triggers": null,
+"unix": 1894953600,
+"year": 2030
+}`
+
+	got := upgradeRawState(t, "time_offset", 0, stateV0)
+
+	expected := map[string]tftypes.Value{
+		"base_rfc3339":    tftypes.NewValue(tftypes.String, "2030-01-17T10:00:00+02:00"),
+		"rfc3339":         tftypes.NewValue(tftypes.String, "2030-01-18T10:00:00+02:00"),
+		"offset_days":     tftypes.NewValue(tftypes.Number, 1),
+		"offset_duration": tftypes.NewValue(tftypes.String, nil),
+		"timezone":        tftypes.NewValue(tftypes.String, nil),
+		"track_age":       tftypes.NewValue(tftypes.Bool, nil),
+		"local_rfc3339":   tftypes.NewValue(tftypes.String, "2030-01-18T08:00:00Z"),
+		"local_day":       tftypes.NewValue(tftypes.Number, 18),
+		"local_hour":      tftypes.NewValue(tftypes.Number, 8),
+		"local_year":      tftypes.NewValue(tftypes.Number, 2030),
+	}
+
+	for attribute, value := range expected {
+		if !got[attribute].Equal(value) {
+			t.Errorf("expected %s to be %s, got: %s", attribute, value, got[attribute])
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -17,17 +18,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
 	"github.com/hashicorp/terraform-provider-time/internal/clock"
 	"github.com/hashicorp/terraform-provider-time/internal/modifiers/timemodifier"
+	"github.com/hashicorp/terraform-provider-time/internal/validators/timevalidator"
 )
 
 var (
@@ -36,6 +43,9 @@ var (
 	_ resource.ResourceWithModifyPlan       = (*timeRotatingResource)(nil)
 	_ resource.ResourceWithConfigValidators = (*timeRotatingResource)(nil)
 	_ resource.ResourceWithConfigure        = (*timeRotatingResource)(nil)
+	_ resource.ResourceWithUpgradeState     = (*timeRotatingResource)(nil)
+	_ resource.ResourceWithMoveState        = (*timeRotatingResource)(nil)
+	_ resource.ResourceWithIdentity         = (*timeRotatingResource)(nil)
 )
 
 func NewTimeRotatingResource() resource.Resource {
@@ -69,20 +79,111 @@ func (t *timeRotatingResource) Configure(ctx context.Context, req resource.Confi
 
 func (t *timeRotatingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_rotating"
+
+	// The identity includes arguments that can be updated in place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 2,
 		Description: "Manages a rotating time resource, which keeps a rotating UTC timestamp stored in the Terraform " +
 			"state and proposes resource recreation when the locally sourced current time is beyond the rotation time. " +
 			"This rotation only occurs when Terraform is executed, meaning there will be drift between the rotation " +
-			"timestamp and actual rotation. The new rotation timestamp offset includes this drift. " +
+			"timestamp and actual rotation. The new rotation timestamp offset includes this drift, unless " +
+			"`anchor_rfc3339` is configured to keep the rotations on a fixed schedule. " +
 			"This prevents perpetual differences caused by using the [`timestamp()` function](https://www.terraform.io/docs/configuration/functions/timestamp.html) " +
 			"by only forcing a new value on the set cadence.",
 		Attributes: map[string]schema.Attribute{
+			"align_to": schema.StringAttribute{
+				Description: "Calendar boundary to align the rotation timestamp to, which is one of `hour`, `day`, `week`, " +
+					"`month`, `quarter` or `year`. After the 'rotation_' arguments are added to the base timestamp, the rotation " +
+					"timestamp is moved forward to the start of the next calendar period unless it is already at the start of one. " +
+					"Conflicts with `rotation_cron` and `rotation_rfc3339`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(calendar.Hour),
+						string(calendar.Day),
+						string(calendar.Week),
+						string(calendar.Month),
+						string(calendar.Quarter),
+						string(calendar.Year),
+					),
+				},
+			},
+			"anchor_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Description: "Anchor timestamp in [RFC3339](https://datatracker.ietf.org/doc/html/rfc3339#section-5.8) format " +
+					"that the rotations are scheduled from. When configured, the rotation timestamp is the first anchor timestamp " +
+					"plus a whole number of rotation periods, made up of the 'rotation_' arguments, that is after the base " +
+					"timestamp. Rotations then stay on the same schedule instead of including the drift between the rotation " +
+					"timestamp and the next apply. Conflicts with `align_to`, `rotation_cron` and `rotation_rfc3339`.",
+				Optional: true,
+			},
+			"applied_jitter": schema.StringAttribute{
+				Description: "Jitter added to the rotation timestamp when `rotation_jitter` is configured, " +
+					"as a Go duration, e.g. `1h23m45s`.",
+				Computed: true,
+			},
+			"base_components": schema.ObjectAttribute{
+				Description: "Components of the base timestamp, `rfc3339`, in UTC: the `year`, `month`, `day`, " +
+					"`hour`, `minute` and `second` numbers, and `unix`, the number of seconds since epoch time.",
+				AttributeTypes: timeRotatingComponentsAttrTypes,
+				Computed:       true,
+			},
+			"clock_skew_tolerance": schema.StringAttribute{
+				Description: "Duration, as a Go or ISO 8601 duration, e.g. `5m`, that the current time must have passed the " +
+					"rotation timestamp by before the resource is rotated. This keeps plan and apply, or machines with slightly " +
+					"different clocks, from disagreeing whether a rotation is due.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.PositiveDuration(),
+				},
+			},
 			"day": schema.Int64Attribute{
-				Description: "Number day of timestamp.",
-				Computed:    true,
+				Description:        "Number day of the rotation timestamp. Deprecated, use `rotation_components.day` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.day instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"rotate_before": schema.ListAttribute{
+				Description: "List of [RFC3339](https://datatracker.ietf.org/doc/html/rfc3339#section-5.8) deadlines, e.g. " +
+					"certificate expiry timestamps, that the resource must be rotated before. The rotation timestamp is the " +
+					"earliest of the timestamp calculated from the other 'rotation_' arguments and each deadline minus " +
+					"`lead_time`. Deadlines that are not after the base timestamp once `lead_time` is subtracted are ignored. " +
+					"Configuring `lead_time` therefore also moves these rotations earlier. Conflicts with `rotation_rfc3339`.",
+				ElementType: timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"rotation_business_days": schema.Int64Attribute{
+				Description: "Number of business days to add to the base timestamp, after any other 'rotation_' arguments, " +
+					"to configure the rotation timestamp. Weekend days and holidays are skipped, see `weekend_days` and " +
+					"`holidays`. When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
+					"At least one of the 'rotation_' arguments must be configured.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotation_components": schema.ObjectAttribute{
+				Description: "Components of the rotation timestamp, `rotation_rfc3339`, in UTC: the `year`, `month`, `day`, " +
+					"`hour`, `minute` and `second` numbers, and `unix`, the number of seconds since epoch time.",
+				AttributeTypes: timeRotatingComponentsAttrTypes,
+				Computed:       true,
+			},
+			"rotation_cron": schema.StringAttribute{
+				Description: "Cron expression used to configure the rotation timestamp, which is set to the next " +
+					"occurrence of the schedule after the base timestamp. The expression uses the standard five fields " +
+					"(minute, hour, day of month, month, day of week) and may be prefixed with `CRON_TZ=<time zone>` to " +
+					"evaluate the schedule in an [IANA time zone](https://www.iana.org/time-zones), " +
+					"e.g. `CRON_TZ=Europe/Berlin 0 3 * 1,4,7,10 MON#1`. " +
+					"When other 'rotation_' arguments are configured, the next occurrence after the offset timestamp is used. " +
+					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
+					"At least one of the 'rotation_' arguments must be configured.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Cron(),
+				},
 			},
 			"rotation_days": schema.Int64Attribute{
 				Description: "Number of days to add to the base timestamp to configure the rotation timestamp. " +
@@ -93,6 +194,18 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 					int64validator.AtLeast(1),
 				},
 			},
+			"rotation_duration": schema.StringAttribute{
+				Description: "Duration to add to the base timestamp to configure the rotation timestamp, either as a " +
+					"[Go duration](https://pkg.go.dev/time#ParseDuration), e.g. `36h`, or as an " +
+					"[ISO 8601 duration](https://en.wikipedia.org/wiki/ISO_8601#Durations), e.g. `P1M2DT3H`. " +
+					"Years, months, weeks and days of ISO 8601 durations are added as calendar units. " +
+					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
+					"At least one of the 'rotation_' arguments must be configured.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.PositiveDuration(),
+				},
+			},
 			"rotation_hours": schema.Int64Attribute{
 				Description: "Number of hours to add to the base timestamp to configure the rotation timestamp. " +
 					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
@@ -102,6 +215,16 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 					int64validator.AtLeast(1),
 				},
 			},
+			"rotation_jitter": schema.StringAttribute{
+				Description: "Maximum jitter to add to the rotation timestamp, as a Go or ISO 8601 duration, e.g. `6h`. " +
+					"The jitter is derived from a hash of `jitter_seed`, so it is stable across plans while spreading " +
+					"the rotations of many resources. Requires `jitter_seed`. Conflicts with `rotation_rfc3339`.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.PositiveDuration(),
+					stringvalidator.AlsoRequires(path.MatchRoot("jitter_seed")),
+				},
+			},
 			"rotation_minutes": schema.Int64Attribute{
 				Description: "Number of minutes to add to the base timestamp to configure the rotation timestamp. " +
 					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
@@ -111,6 +234,16 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 					int64validator.AtLeast(1),
 				},
 			},
+			"rotation_mode": schema.StringAttribute{
+				Description: "How the resource is rotated when the current time has passed the rotation timestamp. " +
+					"With `replace`, the default, the resource is removed from state and recreated. With `in_place`, " +
+					"the new base and rotation timestamps are planned as an update and `generation` is increased. " +
+					"Configuring `history_size` always rotates in place. Conflicts with `rfc3339` and `rotation_rfc3339`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rotationModeReplace, rotationModeInPlace),
+				},
+			},
 			"rotation_months": schema.Int64Attribute{
 				Description: "Number of months to add to the base timestamp to configure the rotation timestamp. " +
 					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
@@ -128,11 +261,14 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 					"At least one of the 'rotation_' arguments must be configured.",
 				Optional: true,
 				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIf(
-						timemodifier.ReplaceIfOutdated,
-						"resource will be replaced if current time is past the saved time",
-						"resource will be replaced if current time is past the saved time"),
+			},
+			"rotation_seconds": schema.Int64Attribute{
+				Description: "Number of seconds to add to the base timestamp to configure the rotation timestamp. " +
+					"When the current time has passed the rotation timestamp, the resource will trigger recreation. " +
+					"At least one of the 'rotation_' arguments must be configured.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 			},
 			"rotation_years": schema.Int64Attribute{
@@ -144,8 +280,42 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 					int64validator.AtLeast(1),
 				},
 			},
+			"holidays": schema.ListAttribute{
+				Description: "Dates in `YYYY-MM-DD` format, e.g. `2030-12-25`, that are not business days. Dates are in the " +
+					"configured `timezone`, or in UTC when `timezone` is not configured.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(timevalidator.Date()),
+				},
+			},
 			"hour": schema.Int64Attribute{
-				Description: "Number hour of timestamp.",
+				Description:        "Number hour of the rotation timestamp. Deprecated, use `rotation_components.hour` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.hour instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"local_day": schema.Int64Attribute{
+				Description: "Number day of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `day` when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_hour": schema.Int64Attribute{
+				Description: "Number hour of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `hour` when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_minute": schema.Int64Attribute{
+				Description: "Number minute of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `minute` when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_month": schema.Int64Attribute{
+				Description: "Number month of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `month` when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_second": schema.Int64Attribute{
+				Description: "Number second of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `second` when `timezone` is not configured.",
+				Computed:    true,
+			},
+			"local_year": schema.Int64Attribute{
+				Description: "Number year of the rotation timestamp (`rotation_rfc3339`) in the configured `timezone`, or the same as `year` when `timezone` is not configured.",
 				Computed:    true,
 			},
 			"triggers": schema.MapAttribute{
@@ -159,11 +329,64 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"minute": schema.Int64Attribute{
-				Description: "Number minute of timestamp.",
-				Computed:    true,
+				Description:        "Number minute of the rotation timestamp. Deprecated, use `rotation_components.minute` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.minute instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
 			},
 			"month": schema.Int64Attribute{
-				Description: "Number month of timestamp.",
+				Description:        "Number month of the rotation timestamp. Deprecated, use `rotation_components.month` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.month instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"generation": schema.Int64Attribute{
+				Description: "Number of the current rotation, starting at `1` and increased by every in-place rotation. " +
+					"Only set when the resource is rotated in place.",
+				Computed: true,
+			},
+			"history_size": schema.Int64Attribute{
+				Description: "Number of previous rotations to keep in `previous_rotations`. When configured, the resource " +
+					"is rotated in place with an update instead of being recreated, so the history survives rotation. " +
+					"Conflicts with `rfc3339` and `rotation_rfc3339`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"in_lead_window": schema.BoolAttribute{
+				Description: "Whether the current time has passed the rotation timestamp minus `lead_time`, refreshed " +
+					"on every read. Only set when `lead_time` is configured.",
+				Computed: true,
+			},
+			"jitter_seed": schema.StringAttribute{
+				Description: "Seed used to derive the `rotation_jitter` offset, which should be unique to the resource, " +
+					"e.g. the workspace and resource name. Resources with different seeds rotate at different times within " +
+					"the jitter, while resources with the same seed rotate together. Required with `rotation_jitter`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("rotation_jitter")),
+				},
+			},
+			"lead_time": schema.StringAttribute{
+				Description: "Duration before the rotation timestamp, as a Go or ISO 8601 duration, e.g. `72h`, during " +
+					"which `in_lead_window` is `true`, so that a replacement can be prepared before the rotation. Also " +
+					"subtracted from the `rotate_before` timestamps, so a rotation caused by a deadline is due `lead_time` " +
+					"before the deadline and `in_lead_window` is `true` from twice `lead_time` before the deadline.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.PositiveDuration(),
+				},
+			},
+			"next_rotation_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Description: "Rotation timestamp following the current one, assuming the resource is rotated exactly " +
+					"at the rotation timestamp. The `rotation_jitter` is added to it once, the same as to the current one. " +
+					"Not set when `rotation_rfc3339` is configured.",
+				Computed: true,
+			},
+			"previous_rotations": schema.ListAttribute{
+				Description: "Previous rotations, most recent first, when `history_size` is configured. Each rotation has " +
+					"the `base_rfc3339` and `rotation_rfc3339` timestamps it was created with.",
+				ElementType: types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes},
 				Computed:    true,
 			},
 			"rfc3339": schema.StringAttribute{
@@ -179,16 +402,66 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"second": schema.Int64Attribute{
-				Description: "Number second of timestamp.",
-				Computed:    true,
+				Description:        "Number second of the rotation timestamp. Deprecated, use `rotation_components.second` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.second instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"seconds_until_rotation": schema.Int64Attribute{
+				Description: "Number of seconds until the rotation timestamp, refreshed on every read, or negative once " +
+					"the rotation is due. Only set when `track_rotation` is `true`.",
+				Computed: true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "[IANA time zone](https://www.iana.org/time-zones) name, e.g. `Europe/Berlin`, used to calculate the " +
+					"rotation timestamp. Years, months and days are added in local time, so the rotation stays at the same " +
+					"local time across daylight saving time changes. When configured, computed timestamps include the time zone " +
+					"offset and the `base_components`, `rotation_components` and deprecated `year`, `month`, `day`, `hour`, `minute` and `second` attributes are in UTC.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.Timezone(),
+				},
+			},
+			"track_rotation": schema.BoolAttribute{
+				Description: "Whether to set `seconds_until_rotation`, which is refreshed on every read.",
+				Optional:    true,
 			},
 			"unix": schema.Int64Attribute{
-				Description: "Number of seconds since epoch time, e.g. `1581489373`.",
-				Computed:    true,
+				Description:        "Number of seconds since epoch time of the rotation timestamp, e.g. `1581489373`. Deprecated, use `rotation_components.unix` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.unix instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"warn_before": schema.StringAttribute{
+				Description: "Duration before the rotation timestamp, as a Go or ISO 8601 duration, e.g. `168h`, during which " +
+					"planning and refreshing emit a warning with the time remaining until the rotation.",
+				Optional: true,
+				Validators: []validator.String{
+					timevalidator.PositiveDuration(),
+				},
+			},
+			"week_start": schema.StringAttribute{
+				Description: "Day of the week that weeks start on when `align_to` is `week`, e.g. `SUNDAY`. Defaults to `MONDAY`.",
+				Optional:    true,
+				Validators: []validator.String{
+					timevalidator.Weekday(),
+					stringvalidator.AlsoRequires(path.MatchRoot("align_to")),
+				},
 			},
 			"year": schema.Int64Attribute{
-				Description: "Number year of timestamp.",
-				Computed:    true,
+				Description:        "Number year of the rotation timestamp. Deprecated, use `rotation_components.year` instead.",
+				Computed:           true,
+				DeprecationMessage: "Use rotation_components.year instead. This attribute describes the rotation timestamp, not the base timestamp in rfc3339 and id.",
+			},
+			"weekend_days": schema.ListAttribute{
+				Description: "Days of the week that are not business days, e.g. `[\"FRIDAY\", \"SATURDAY\"]`. Defaults to " +
+					"Saturday and Sunday. When `rotation_business_days`, `weekend_days` or `holidays` is configured, a rotation " +
+					"timestamp on a weekend day or holiday is moved to the same time on the next business day.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(6),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(timevalidator.Weekday()),
+				},
 			},
 			"id": schema.StringAttribute{
 				CustomType:  timetypes.RFC3339Type{},
@@ -196,6 +469,46 @@ func (t *timeRotatingResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"maintenance_window": schema.SingleNestedBlock{
+				Description: "Recurring window that rotations are restricted to. When the rotation timestamp has passed " +
+					"outside the window, the rotation is deferred until the current time is inside the next opening of " +
+					"the window.",
+				Attributes: map[string]schema.Attribute{
+					"days_of_week": schema.ListAttribute{
+						Description: "Days of the week that the window opens on, e.g. `[\"SATURDAY\", \"SUNDAY\"]`.",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(timevalidator.Weekday()),
+						},
+					},
+					"duration": schema.StringAttribute{
+						Description: "Duration that the window stays open for, as a Go or ISO 8601 duration, e.g. `4h`.",
+						Required:    true,
+						Validators: []validator.String{
+							timevalidator.PositiveDuration(),
+						},
+					},
+					"start_time": schema.StringAttribute{
+						Description: "Local time of day that the window opens at, in `HH:MM` format, e.g. `22:00`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day in HH:MM format"),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Description: "[IANA time zone](https://www.iana.org/time-zones) name that `start_time` is in, " +
+							"e.g. `Europe/Berlin`. Defaults to the resource `timezone`, or UTC when it is not configured.",
+						Optional: true,
+						Validators: []validator.String{
+							timevalidator.Timezone(),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -207,8 +520,48 @@ func (t *timeRotatingResource) ConfigValidators(ctx context.Context) []resource.
 			path.MatchRoot("rotation_days"),
 			path.MatchRoot("rotation_months"),
 			path.MatchRoot("rotation_years"),
+			path.MatchRoot("rotation_seconds"),
+			path.MatchRoot("rotation_duration"),
+			path.MatchRoot("rotation_rfc3339"),
+			path.MatchRoot("rotation_cron"),
+			path.MatchRoot("rotate_before"),
+			path.MatchRoot("rotation_business_days"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("rotation_cron"),
+			path.MatchRoot("rotation_rfc3339"),
+			path.MatchRoot("align_to"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("rotation_jitter"),
+			path.MatchRoot("rotation_rfc3339"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("history_size"),
+			path.MatchRoot("rotation_rfc3339"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("history_size"),
+			path.MatchRoot("rfc3339"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("rotation_mode"),
+			path.MatchRoot("rotation_rfc3339"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("rotation_mode"),
+			path.MatchRoot("rfc3339"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("rotate_before"),
 			path.MatchRoot("rotation_rfc3339"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("anchor_rfc3339"),
+			path.MatchRoot("rotation_cron"),
+			path.MatchRoot("rotation_rfc3339"),
+			path.MatchRoot("align_to"),
+		),
 	}
 }
 
@@ -224,7 +577,7 @@ func (t *timeRotatingResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	var state, plan timeRotatingModelV0
+	var state, plan timeRotatingModelV2
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -238,12 +591,62 @@ func (t *timeRotatingResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	if state.RotationYears == plan.RotationYears &&
+	resp.Diagnostics.Append(t.warnBeforeRotation(&state, &plan)...)
+
+	expired, diags := t.rotationExpired(ctx, &state, &plan)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Expired resources are usually removed from state during Read. This covers
+	// plans without a refresh and rotations that become due between Read and
+	// ModifyPlan. A configured rotation_rfc3339 cannot change, so only the
+	// removal during Read applies to it.
+	if expired && !rotatesInPlace(&plan) {
+		var configRotationRFC3339 timetypes.RFC3339
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotation_rfc3339"), &configRotationRFC3339)...)
+
+		if resp.Diagnostics.HasError() || !configRotationRFC3339.IsNull() {
+			return
+		}
+
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotation_rfc3339"))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotation_rfc3339"), timetypes.NewRFC3339Unknown())...)
+
+		return
+	}
+
+	if !expired &&
+		state.RotationYears == plan.RotationYears &&
 		state.RotationMonths == plan.RotationMonths &&
 		state.RotationDays == plan.RotationDays &&
 		state.RotationHours == plan.RotationHours &&
 		state.RotationMinutes == plan.RotationMinutes &&
-		state.RotationRFC3339 == plan.RotationRFC3339 {
+		state.RotationSeconds == plan.RotationSeconds &&
+		state.RotationDuration == plan.RotationDuration &&
+		state.RotationRFC3339 == plan.RotationRFC3339 &&
+		state.RotationCron == plan.RotationCron &&
+		state.AlignTo == plan.AlignTo &&
+		state.WeekStart == plan.WeekStart &&
+		state.Timezone == plan.Timezone &&
+		state.RotationJitter == plan.RotationJitter &&
+		state.JitterSeed == plan.JitterSeed &&
+		state.HistorySize == plan.HistorySize &&
+		state.LeadTime == plan.LeadTime &&
+		state.RotationMode == plan.RotationMode &&
+		state.MaintenanceWindow.Equal(plan.MaintenanceWindow) &&
+		state.WarnBefore == plan.WarnBefore &&
+		state.ClockSkewTolerance == plan.ClockSkewTolerance &&
+		state.AnchorRFC3339 == plan.AnchorRFC3339 &&
+		state.RotateBefore.Equal(plan.RotateBefore) &&
+		state.RotationBusinessDays == plan.RotationBusinessDays &&
+		state.WeekendDays.Equal(plan.WeekendDays) &&
+		state.Holidays.Equal(plan.Holidays) &&
+		state.TrackRotation == plan.TrackRotation {
 		return
 	}
 
@@ -284,95 +687,69 @@ func (t *timeRotatingResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	resp.Diagnostics.Append(setRotationValues(&plan, timestamp)...)
+	previousRotations, diags := previousRotationsFromList(ctx, state.PreviousRotations)
+
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = resp.Plan.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
+	plan.Generation = types.Int64Null()
 
-func (t *timeRotatingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
-	var state timeRotatingModelV0
-	var err error
+	if rotatesInPlace(&plan) {
+		generation := state.Generation.ValueInt64()
 
-	idParts := strings.Split(id, ",")
+		if state.Generation.IsNull() {
+			generation = 1
+		}
 
-	if len(idParts) != 2 && len(idParts) != 6 {
-		resp.Diagnostics.AddError(
-			"Unexpected Format of ID",
-			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES or BASETIMESTAMP,ROTATIONTIMESTAMP", id))
+		if expired {
+			generation++
+		}
 
-		return
+		plan.Generation = types.Int64Value(generation)
 	}
 
-	if len(idParts) == 2 {
-		if idParts[0] == "" || idParts[1] == "" {
-			resp.Diagnostics.AddError(
-				"Unexpected Format of ID",
-				fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,ROTATIONTIMESTAMP", id))
-			return
-		}
+	// An expired resource that rotates in place is given a new base timestamp,
+	// the same as a newly created resource, and the expired rotation is added to
+	// the history. Expiry is only decided here, so the new values depend on the
+	// time of the apply and are calculated during Update, which recognises the
+	// rotation by the increased generation.
+	if expired {
+		setRotationValuesUnknown(&plan, timestamp)
 
-		state, err = parseTwoPartId(idParts)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Import time rotating error",
-				"The timestamp that was supplied could not be parsed as RFC3339.\n\n+"+
-					fmt.Sprintf("Original Error: %s", err),
-			)
-			return
+		plan.RFC3339 = timetypes.NewRFC3339Unknown()
+		plan.ID = timetypes.NewRFC3339Unknown()
+		plan.BaseComponents = types.ObjectUnknown(timeRotatingComponentsAttrTypes)
+
+		if plan.HistorySize.ValueInt64() > 0 {
+			plan.PreviousRotations = types.ListUnknown(types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes})
 		}
 
-	} else {
-		if idParts[0] == "" || (idParts[1] == "" && idParts[2] == "" && idParts[3] == "" && idParts[4] == "" && idParts[5] == "") {
-			resp.Diagnostics.AddError(
-				"Unexpected Format of ID",
-				fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES where at least one rotation value is non-empty", id))
+		diags = resp.Plan.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
 
-			return
-		}
-		state, err = parseMultiplePartId(idParts)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Import time rotating error",
-				"The parameter that was supplied could not be parsed.\n\n+"+
-					fmt.Sprintf("Original Error: %s", err),
-			)
-			return
-		}
+		return
 	}
 
-	state.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	plan.PreviousRotations, diags = previousRotationsToList(ctx, previousRotations, plan.HistorySize)
 
-	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
-}
-
-func (t *timeRotatingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan timeRotatingModelV0
 
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	timestamp := t.clock.Now().UTC()
-
-	if plan.RFC3339.ValueString() != "" {
-		rfc3339, diags := plan.RFC3339.ValueRFC3339Time()
+	// The rotate_before deadlines commonly reference resources that change in
+	// the same apply, in which case the rotation is calculated during Update.
+	if !isFullyKnown(plan.RotateBefore) {
+		setRotationValuesUnknown(&plan, timestamp)
 
+		diags = resp.Plan.Set(ctx, plan)
 		resp.Diagnostics.Append(diags...)
 
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		timestamp = rfc3339
+		return
 	}
 
 	resp.Diagnostics.Append(setRotationValues(&plan, timestamp)...)
@@ -381,259 +758,1724 @@ func (t *timeRotatingResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
-
-func (t *timeRotatingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state timeRotatingModelV0
+	// The lead window and the remaining time are only known once applied, as
+	// they depend on the time of the apply.
+	plan.InLeadWindow = types.BoolNull()
 
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !plan.LeadTime.IsNull() {
+		plan.InLeadWindow = types.BoolUnknown()
 	}
 
-	if !state.RotationRFC3339.IsNull() && state.RotationRFC3339.ValueString() != "" {
-		now := t.clock.Now().UTC()
-		rotationTimestamp, diags := state.RotationRFC3339.ValueRFC3339Time()
-
-		resp.Diagnostics.Append(diags...)
-
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	plan.SecondsUntilRotation = types.Int64Null()
 
-		if now.After(rotationTimestamp) {
-			log.Printf("[INFO] Expiration timestamp (%s) is after current timestamp (%s), removing from state", state.RotationRFC3339.ValueString(), now.Format(time.RFC3339))
-			resp.State.RemoveResource(ctx)
-			return
-		}
+	if plan.TrackRotation.ValueBool() {
+		plan.SecondsUntilRotation = types.Int64Unknown()
 	}
 
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
-func (t *timeRotatingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state timeRotatingModelV0
-
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// rotationExpired reports whether the rotation timestamp in state has passed
+// for a resource that is rotated in place. Other resources are removed from
+// state by Read and recreated instead.
+func (t *timeRotatingResource) rotationExpired(ctx context.Context, state *timeRotatingModelV2, plan *timeRotatingModelV2) (bool, diag.Diagnostics) {
+	if state.RotationRFC3339.ValueString() == "" {
+		return false, nil
 	}
 
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	rotationTimestamp, diags := state.RotationRFC3339.ValueRFC3339Time()
 
-	if state.RotationYears == plan.RotationYears &&
-		state.RotationMonths == plan.RotationMonths &&
-		state.RotationDays == plan.RotationDays &&
-		state.RotationHours == plan.RotationHours &&
-		state.RotationMinutes == plan.RotationMinutes &&
-		state.RotationRFC3339 == plan.RotationRFC3339 {
-		return
+	if diags.HasError() || rotationTimestamp.IsZero() {
+		return false, diags
 	}
 
-	timestamp, diags := plan.ID.ValueRFC3339Time()
+	var skew time.Duration
 
-	resp.Diagnostics.Append(diags...)
+	if plan.ClockSkewTolerance.ValueString() != "" {
+		clockSkewTolerance, err := calendar.ParsePeriod(plan.ClockSkewTolerance.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("clock_skew_tolerance"),
+				"Invalid Duration",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return false, diags
+		}
 
-	if resp.Diagnostics.HasError() {
-		return
+		skew = clockSkewTolerance.AddTo(rotationTimestamp).Sub(rotationTimestamp)
 	}
 
-	resp.Diagnostics.Append(setRotationValues(&plan, timestamp)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	if !timemodifier.Outdated(t.clock, rotationTimestamp, skew) {
+		return false, diags
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
+	open, windowDiags := maintenanceWindowOpen(ctx, plan, t.clock.Now())
 
-func (t *timeRotatingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	diags.Append(windowDiags...)
+
+	if !open && !diags.HasError() {
+		log.Printf("[INFO] Expiration timestamp (%s) is before current timestamp (%s), deferring rotation to the next maintenance window", state.RotationRFC3339.ValueString(), t.clock.Now().UTC().Format(time.RFC3339))
+	}
 
+	return open, diags
 }
 
-type timeRotatingModelV0 struct {
-	Day             types.Int64       `tfsdk:"day"`
-	RotationDays    types.Int64       `tfsdk:"rotation_days"`
-	RotationHours   types.Int64       `tfsdk:"rotation_hours"`
-	RotationMinutes types.Int64       `tfsdk:"rotation_minutes"`
-	RotationMonths  types.Int64       `tfsdk:"rotation_months"`
-	RotationRFC3339 timetypes.RFC3339 `tfsdk:"rotation_rfc3339"`
-	RotationYears   types.Int64       `tfsdk:"rotation_years"`
-	Hour            types.Int64       `tfsdk:"hour"`
-	Triggers        types.Map         `tfsdk:"triggers"`
-	Minute          types.Int64       `tfsdk:"minute"`
-	Month           types.Int64       `tfsdk:"month"`
-	RFC3339         timetypes.RFC3339 `tfsdk:"rfc3339"`
-	Second          types.Int64       `tfsdk:"second"`
-	Unix            types.Int64       `tfsdk:"unix"`
-	Year            types.Int64       `tfsdk:"year"`
-	ID              timetypes.RFC3339 `tfsdk:"id"`
-}
-
-func setRotationValues(plan *timeRotatingModelV0, timestamp time.Time) diag.Diagnostics {
+// warnBeforeRotation returns a warning with the remaining time when the
+// rotation timestamp in state is within the configured warn_before duration.
+// It is called during both Read and ModifyPlan, so the warning is also shown
+// by a refresh-only plan.
+func (t *timeRotatingResource) warnBeforeRotation(state *timeRotatingModelV2, plan *timeRotatingModelV2) diag.Diagnostics {
 	var diags diag.Diagnostics
-	var rotationTimestamp time.Time
 
-	if plan.RotationDays.ValueInt64() != 0 {
-		rotationTimestamp = timestamp.AddDate(0, 0, int(plan.RotationDays.ValueInt64()))
+	if plan.WarnBefore.ValueString() == "" || state.RotationRFC3339.ValueString() == "" {
+		return diags
 	}
 
-	if plan.RotationHours.ValueInt64() != 0 {
-		hours := time.Duration(plan.RotationHours.ValueInt64()) * time.Hour
-		rotationTimestamp = timestamp.Add(hours)
+	rotationTimestamp, diags := state.RotationRFC3339.ValueRFC3339Time()
+
+	if diags.HasError() || rotationTimestamp.IsZero() {
+		return diags
 	}
 
-	if plan.RotationMinutes.ValueInt64() != 0 {
-		minutes := time.Duration(plan.RotationMinutes.ValueInt64()) * time.Minute
-		rotationTimestamp = timestamp.Add(minutes)
+	warnBefore, err := calendar.ParsePeriod(plan.WarnBefore.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("warn_before"),
+			"Invalid Duration",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return diags
 	}
 
-	if plan.RotationMonths.ValueInt64() != 0 {
-		rotationTimestamp = timestamp.AddDate(0, int(plan.RotationMonths.ValueInt64()), 0)
+	now := t.clock.Now()
+
+	if now.After(rotationTimestamp) || now.Before(warnBefore.Negate().AddTo(rotationTimestamp)) {
+		return diags
 	}
 
-	if plan.RotationRFC3339.ValueString() != "" {
+	rotation := "replace this resource and the resources that depend on it"
+
+	if rotatesInPlace(plan) {
+		rotation = "update this resource in place and the resources that depend on it"
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("rotation_rfc3339"),
+		"Rotation Approaching",
+		fmt.Sprintf("The rotation timestamp (%s) of this time_rotating resource is in %s, within the warn_before "+
+			"duration (%s). The first apply after the rotation timestamp has passed will %s.",
+			state.RotationRFC3339.ValueString(), rotationTimestamp.Sub(now).Round(time.Second),
+			plan.WarnBefore.ValueString(), rotation),
+	)
+
+	return diags
+}
+
+// now returns the current time as the base timestamp, in the configured time
+// zone if any.
+func (t *timeRotatingResource) now(timezone types.String) (time.Time, diag.Diagnostics) {
+	timestamp := t.clock.Now().UTC()
+
+	location, diags := loadTimezone(timezone)
+
+	if location != nil {
+		timestamp = timestamp.In(location)
+	}
+
+	return timestamp, diags
+}
+
+func (t *timeRotatingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"rfc3339": identityschema.StringAttribute{
+				Description:       "Base timestamp in RFC3339 format, e.g. `2020-02-12T06:36:13Z`.",
+				RequiredForImport: true,
+			},
+			"rotation_cron": identityschema.StringAttribute{
+				Description:       "Cron expression that the rotation timestamp is scheduled by.",
+				OptionalForImport: true,
+			},
+			"rotation_days": identityschema.Int64Attribute{
+				Description:       "Number of days to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_duration": identityschema.StringAttribute{
+				Description:       "Go or ISO 8601 duration to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_hours": identityschema.Int64Attribute{
+				Description:       "Number of hours to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_minutes": identityschema.Int64Attribute{
+				Description:       "Number of minutes to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_months": identityschema.Int64Attribute{
+				Description:       "Number of months to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_rfc3339": identityschema.StringAttribute{
+				Description:       "Configured rotation timestamp in RFC3339 format, e.g. `2020-02-12T06:36:13Z`.",
+				OptionalForImport: true,
+			},
+			"rotation_seconds": identityschema.Int64Attribute{
+				Description:       "Number of seconds to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+			"rotation_years": identityschema.Int64Attribute{
+				Description:       "Number of years to add to the base timestamp to configure the rotation timestamp.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+func (t *timeRotatingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	var state timeRotatingModelV2
+	var err error
+
+	if id == "" && req.Identity != nil {
+		var identity timeRotatingIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id = identity.importID()
+	}
+
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if isKeyValueImportID(id) {
+		var diags diag.Diagnostics
+
+		id, triggers, diags = timeRotatingKeyValueImportID(ctx, id)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Cron expressions contain spaces and may contain commas, so the remainder
+	// of the ID after the base timestamp is used as a whole.
+	if baseRfc3339, cron, ok := strings.Cut(id, ","); ok && isCronImportIdPart(cron) {
+		var diags diag.Diagnostics
+
+		state, diags = parseCronId(baseRfc3339, cron)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Triggers = triggers
+		state.PreviousRotations = types.ListNull(types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes})
+		state.MaintenanceWindow = types.ObjectNull(timeRotatingMaintenanceWindowAttrTypes)
+		state.RotateBefore = types.ListNull(timetypes.RFC3339Type{})
+		state.WeekendDays = types.ListNull(types.StringType)
+		state.Holidays = types.ListNull(types.StringType)
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Identity != nil {
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeRotatingIdentity(state))...)
+		}
+		return
+	}
+
+	idParts := strings.Split(id, ",")
+
+	if len(idParts) != 2 && len(idParts) != 6 && len(idParts) != 7 {
+		resp.Diagnostics.AddError(
+			"Unexpected Format of ID",
+			fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES[,SECONDS], BASETIMESTAMP,ROTATIONTIMESTAMP, BASETIMESTAMP,DURATION or BASETIMESTAMP,CRONEXPRESSION", id))
+
+		return
+	}
+
+	if len(idParts) == 2 {
+		if idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,ROTATIONTIMESTAMP or BASETIMESTAMP,DURATION", id))
+			return
+		}
+
+		if isDurationImportIdPart(idParts[1]) {
+			state, err = parseDurationId(idParts)
+		} else {
+			state, err = parseTwoPartId(idParts)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Import time rotating error",
+				"The timestamp that was supplied could not be parsed as RFC3339.\n\n+"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+	} else {
+		if idParts[0] == "" || strings.Join(idParts[1:], "") == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), expected BASETIMESTAMP,YEARS,MONTHS,DAYS,HOURS,MINUTES[,SECONDS] where at least one rotation value is non-empty", id))
+
+			return
+		}
+		state, err = parseMultiplePartId(idParts)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Import time rotating error",
+				"The parameter that was supplied could not be parsed.\n\n+"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+	}
+
+	state.Triggers = triggers
+	state.PreviousRotations = types.ListNull(types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes})
+	state.MaintenanceWindow = types.ObjectNull(timeRotatingMaintenanceWindowAttrTypes)
+	state.RotateBefore = types.ListNull(timetypes.RFC3339Type{})
+	state.WeekendDays = types.ListNull(types.StringType)
+	state.Holidays = types.ListNull(types.StringType)
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeRotatingIdentity(state))...)
+	}
+}
+
+// MoveState keeps the base timestamp and triggers of a time_offset or
+// time_static resource that is moved to time_rotating. The rotation arguments
+// and timestamp are left null so that the following plan calculates the
+// rotation from the kept base timestamp as an update instead of replacing the
+// resource.
+func (t *timeRotatingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				timestamp, triggers, ok, diags := moveTimeSourceState(ctx, req)
+
+				resp.Diagnostics.Append(diags...)
+
+				if !ok || resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := timeRotatingModelV2{
+					AlignTo:              types.StringNull(),
+					AnchorRFC3339:        timetypes.NewRFC3339Null(),
+					AppliedJitter:        types.StringNull(),
+					BaseComponents:       timeRotatingComponents(timestamp),
+					ClockSkewTolerance:   types.StringNull(),
+					Day:                  types.Int64Null(),
+					RotateBefore:         types.ListNull(timetypes.RFC3339Type{}),
+					RotationBusinessDays: types.Int64Null(),
+					RotationComponents:   types.ObjectNull(timeRotatingComponentsAttrTypes),
+					RotationCron:         types.StringNull(),
+					RotationDays:         types.Int64Null(),
+					RotationDuration:     types.StringNull(),
+					RotationHours:        types.Int64Null(),
+					RotationJitter:       types.StringNull(),
+					RotationMinutes:      types.Int64Null(),
+					RotationMode:         types.StringNull(),
+					RotationMonths:       types.Int64Null(),
+					RotationRFC3339:      timetypes.NewRFC3339Null(),
+					RotationSeconds:      types.Int64Null(),
+					RotationYears:        types.Int64Null(),
+					Hour:                 types.Int64Null(),
+					Generation:           types.Int64Null(),
+					Holidays:             types.ListNull(types.StringType),
+					HistorySize:          types.Int64Null(),
+					LocalDay:             types.Int64Null(),
+					LocalHour:            types.Int64Null(),
+					LocalMinute:          types.Int64Null(),
+					LocalMonth:           types.Int64Null(),
+					LocalSecond:          types.Int64Null(),
+					LocalYear:            types.Int64Null(),
+					JitterSeed:           types.StringNull(),
+					InLeadWindow:         types.BoolNull(),
+					LeadTime:             types.StringNull(),
+					MaintenanceWindow:    types.ObjectNull(timeRotatingMaintenanceWindowAttrTypes),
+					NextRotationRFC3339:  timetypes.NewRFC3339Null(),
+					Triggers:             triggers,
+					Minute:               types.Int64Null(),
+					Month:                types.Int64Null(),
+					PreviousRotations:    types.ListNull(types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes}),
+					RFC3339:              timetypes.NewRFC3339TimeValue(timestamp),
+					Second:               types.Int64Null(),
+					SecondsUntilRotation: types.Int64Null(),
+					Timezone:             types.StringNull(),
+					TrackRotation:        types.BoolNull(),
+					Unix:                 types.Int64Null(),
+					WarnBefore:           types.StringNull(),
+					WeekendDays:          types.ListNull(types.StringType),
+					WeekStart:            types.StringNull(),
+					Year:                 types.Int64Null(),
+					ID:                   timetypes.NewRFC3339TimeValue(timestamp),
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, newTimeRotatingIdentity(state))...)
+				}
+			},
+		},
+	}
+}
+
+func (t *timeRotatingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan timeRotatingModelV2
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.PreviousRotations, diags = previousRotationsToList(ctx, nil, plan.HistorySize)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Generation = types.Int64Null()
+
+	if rotatesInPlace(&plan) {
+		plan.Generation = types.Int64Value(1)
+	}
+
+	timestamp, diags := t.now(plan.Timezone)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RFC3339.ValueString() != "" {
+		rfc3339, diags := plan.RFC3339.ValueRFC3339Time()
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		timestamp = rfc3339
+	}
+
+	resp.Diagnostics.Append(setRotationValues(&plan, timestamp)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setLeadWindow(&plan, t.clock.Now())...)
+	resp.Diagnostics.Append(setSecondsUntilRotation(&plan, t.clock.Now())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeRotatingIdentity(plan))...)
+	}
+}
+
+func (t *timeRotatingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state timeRotatingModelV2
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expired, diags := t.rotationExpired(ctx, &state, &state)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if expired && !rotatesInPlace(&state) {
+		log.Printf("[INFO] Expiration timestamp (%s) is after current timestamp (%s), removing from state", state.RotationRFC3339.ValueString(), t.clock.Now().UTC().Format(time.RFC3339))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(t.warnBeforeRotation(&state, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.LeadTime.IsNull() || state.TrackRotation.ValueBool() {
+		resp.Diagnostics.Append(setLeadWindow(&state, t.clock.Now())...)
+		resp.Diagnostics.Append(setSecondsUntilRotation(&state, t.clock.Now())...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeRotatingIdentity(state))...)
+}
+
+func (t *timeRotatingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state timeRotatingModelV2
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RotationYears == plan.RotationYears &&
+		state.RotationMonths == plan.RotationMonths &&
+		state.RotationDays == plan.RotationDays &&
+		state.RotationHours == plan.RotationHours &&
+		state.RotationMinutes == plan.RotationMinutes &&
+		state.RotationSeconds == plan.RotationSeconds &&
+		state.RotationDuration == plan.RotationDuration &&
+		state.RotationRFC3339 == plan.RotationRFC3339 &&
+		state.RotationCron == plan.RotationCron &&
+		state.AlignTo == plan.AlignTo &&
+		state.WeekStart == plan.WeekStart &&
+		state.Timezone == plan.Timezone &&
+		state.RotationJitter == plan.RotationJitter &&
+		state.JitterSeed == plan.JitterSeed &&
+		state.HistorySize == plan.HistorySize &&
+		state.LeadTime == plan.LeadTime &&
+		state.RotationMode == plan.RotationMode &&
+		state.MaintenanceWindow.Equal(plan.MaintenanceWindow) &&
+		state.WarnBefore == plan.WarnBefore &&
+		state.ClockSkewTolerance == plan.ClockSkewTolerance &&
+		state.AnchorRFC3339 == plan.AnchorRFC3339 &&
+		state.RotateBefore.Equal(plan.RotateBefore) &&
+		state.RotationBusinessDays == plan.RotationBusinessDays &&
+		state.WeekendDays.Equal(plan.WeekendDays) &&
+		state.Holidays.Equal(plan.Holidays) &&
+		state.TrackRotation == plan.TrackRotation &&
+		state.RFC3339 == plan.RFC3339 {
+		return
+	}
+
+	// A rotation in place planned by ModifyPlan adds the expired rotation to the
+	// history.
+	if rotatedInPlace(&state, &plan) {
+		previousRotations, diags := previousRotationsFromList(ctx, state.PreviousRotations)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		previousRotations = append([]timeRotatingPreviousRotationModel{
+			{
+				BaseRFC3339:     state.RFC3339,
+				RotationRFC3339: state.RotationRFC3339,
+			},
+		}, previousRotations...)
+
+		plan.PreviousRotations, diags = previousRotationsToList(ctx, previousRotations, plan.HistorySize)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The base timestamp of a rotation in place is the time of the apply.
+	var timestamp time.Time
+
+	if plan.ID.IsUnknown() {
+		timestamp, diags = t.now(plan.Timezone)
+	} else {
+		timestamp, diags = plan.ID.ValueRFC3339Time()
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setRotationValues(&plan, timestamp)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.InLeadWindow.IsUnknown() {
+		resp.Diagnostics.Append(setLeadWindow(&plan, t.clock.Now())...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.SecondsUntilRotation.IsUnknown() {
+		resp.Diagnostics.Append(setSecondsUntilRotation(&plan, t.clock.Now())...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeRotatingIdentity(plan))...)
+}
+
+func (t *timeRotatingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+
+}
+
+type timeRotatingModelV2 struct {
+	AlignTo              types.String      `tfsdk:"align_to"`
+	AnchorRFC3339        timetypes.RFC3339 `tfsdk:"anchor_rfc3339"`
+	AppliedJitter        types.String      `tfsdk:"applied_jitter"`
+	BaseComponents       types.Object      `tfsdk:"base_components"`
+	ClockSkewTolerance   types.String      `tfsdk:"clock_skew_tolerance"`
+	Day                  types.Int64       `tfsdk:"day"`
+	RotateBefore         types.List        `tfsdk:"rotate_before"`
+	RotationBusinessDays types.Int64       `tfsdk:"rotation_business_days"`
+	RotationComponents   types.Object      `tfsdk:"rotation_components"`
+	RotationCron         types.String      `tfsdk:"rotation_cron"`
+	RotationDays         types.Int64       `tfsdk:"rotation_days"`
+	RotationDuration     types.String      `tfsdk:"rotation_duration"`
+	RotationHours        types.Int64       `tfsdk:"rotation_hours"`
+	RotationJitter       types.String      `tfsdk:"rotation_jitter"`
+	RotationMinutes      types.Int64       `tfsdk:"rotation_minutes"`
+	RotationMode         types.String      `tfsdk:"rotation_mode"`
+	RotationMonths       types.Int64       `tfsdk:"rotation_months"`
+	RotationRFC3339      timetypes.RFC3339 `tfsdk:"rotation_rfc3339"`
+	RotationSeconds      types.Int64       `tfsdk:"rotation_seconds"`
+	RotationYears        types.Int64       `tfsdk:"rotation_years"`
+	Generation           types.Int64       `tfsdk:"generation"`
+	Holidays             types.List        `tfsdk:"holidays"`
+	Hour                 types.Int64       `tfsdk:"hour"`
+	HistorySize          types.Int64       `tfsdk:"history_size"`
+	LocalDay             types.Int64       `tfsdk:"local_day"`
+	LocalHour            types.Int64       `tfsdk:"local_hour"`
+	LocalMinute          types.Int64       `tfsdk:"local_minute"`
+	LocalMonth           types.Int64       `tfsdk:"local_month"`
+	LocalSecond          types.Int64       `tfsdk:"local_second"`
+	LocalYear            types.Int64       `tfsdk:"local_year"`
+	InLeadWindow         types.Bool        `tfsdk:"in_lead_window"`
+	LeadTime             types.String      `tfsdk:"lead_time"`
+	MaintenanceWindow    types.Object      `tfsdk:"maintenance_window"`
+	NextRotationRFC3339  timetypes.RFC3339 `tfsdk:"next_rotation_rfc3339"`
+	JitterSeed           types.String      `tfsdk:"jitter_seed"`
+	Triggers             types.Map         `tfsdk:"triggers"`
+	Minute               types.Int64       `tfsdk:"minute"`
+	Month                types.Int64       `tfsdk:"month"`
+	PreviousRotations    types.List        `tfsdk:"previous_rotations"`
+	RFC3339              timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Second               types.Int64       `tfsdk:"second"`
+	SecondsUntilRotation types.Int64       `tfsdk:"seconds_until_rotation"`
+	Timezone             types.String      `tfsdk:"timezone"`
+	TrackRotation        types.Bool        `tfsdk:"track_rotation"`
+	Unix                 types.Int64       `tfsdk:"unix"`
+	WarnBefore           types.String      `tfsdk:"warn_before"`
+	WeekendDays          types.List        `tfsdk:"weekend_days"`
+	WeekStart            types.String      `tfsdk:"week_start"`
+	Year                 types.Int64       `tfsdk:"year"`
+	ID                   timetypes.RFC3339 `tfsdk:"id"`
+}
+
+type timeRotatingPreviousRotationModel struct {
+	BaseRFC3339     timetypes.RFC3339 `tfsdk:"base_rfc3339"`
+	RotationRFC3339 timetypes.RFC3339 `tfsdk:"rotation_rfc3339"`
+}
+
+var timeRotatingPreviousRotationAttrTypes = map[string]attr.Type{
+	"base_rfc3339":     timetypes.RFC3339Type{},
+	"rotation_rfc3339": timetypes.RFC3339Type{},
+}
+
+type timeRotatingMaintenanceWindowModel struct {
+	DaysOfWeek types.List   `tfsdk:"days_of_week"`
+	Duration   types.String `tfsdk:"duration"`
+	StartTime  types.String `tfsdk:"start_time"`
+	Timezone   types.String `tfsdk:"timezone"`
+}
+
+var timeRotatingMaintenanceWindowAttrTypes = map[string]attr.Type{
+	"days_of_week": types.ListType{ElemType: types.StringType},
+	"duration":     types.StringType,
+	"start_time":   types.StringType,
+	"timezone":     types.StringType,
+}
+
+var timeRotatingComponentsAttrTypes = map[string]attr.Type{
+	"day":    types.Int64Type,
+	"hour":   types.Int64Type,
+	"minute": types.Int64Type,
+	"month":  types.Int64Type,
+	"second": types.Int64Type,
+	"unix":   types.Int64Type,
+	"year":   types.Int64Type,
+}
+
+// timeRotatingComponents returns the UTC components of a timestamp for the
+// base_components and rotation_components attributes.
+func timeRotatingComponents(timestamp time.Time) types.Object {
+	utcTimestamp := timestamp.UTC()
+
+	return types.ObjectValueMust(timeRotatingComponentsAttrTypes, map[string]attr.Value{
+		"day":    types.Int64Value(int64(utcTimestamp.Day())),
+		"hour":   types.Int64Value(int64(utcTimestamp.Hour())),
+		"minute": types.Int64Value(int64(utcTimestamp.Minute())),
+		"month":  types.Int64Value(int64(utcTimestamp.Month())),
+		"second": types.Int64Value(int64(utcTimestamp.Second())),
+		"unix":   types.Int64Value(utcTimestamp.Unix()),
+		"year":   types.Int64Value(int64(utcTimestamp.Year())),
+	})
+}
+
+// timeOfDayRegexp matches a time of day in HH:MM format.
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+const (
+	rotationModeReplace = "replace"
+	rotationModeInPlace = "in_place"
+)
+
+type timeRotatingIdentityModel struct {
+	RFC3339          types.String `tfsdk:"rfc3339"`
+	RotationCron     types.String `tfsdk:"rotation_cron"`
+	RotationDays     types.Int64  `tfsdk:"rotation_days"`
+	RotationDuration types.String `tfsdk:"rotation_duration"`
+	RotationHours    types.Int64  `tfsdk:"rotation_hours"`
+	RotationMinutes  types.Int64  `tfsdk:"rotation_minutes"`
+	RotationMonths   types.Int64  `tfsdk:"rotation_months"`
+	RotationRFC3339  types.String `tfsdk:"rotation_rfc3339"`
+	RotationSeconds  types.Int64  `tfsdk:"rotation_seconds"`
+	RotationYears    types.Int64  `tfsdk:"rotation_years"`
+}
+
+// newTimeRotatingIdentity returns the identity of the resource. The rotation
+// timestamp is only part of the identity when no other rotation argument is
+// set, as it is calculated from those otherwise.
+func newTimeRotatingIdentity(state timeRotatingModelV2) timeRotatingIdentityModel {
+	identity := timeRotatingIdentityModel{
+		RFC3339:          types.StringValue(state.RFC3339.ValueString()),
+		RotationCron:     state.RotationCron,
+		RotationDays:     state.RotationDays,
+		RotationDuration: state.RotationDuration,
+		RotationHours:    state.RotationHours,
+		RotationMinutes:  state.RotationMinutes,
+		RotationMonths:   state.RotationMonths,
+		RotationRFC3339:  types.StringNull(),
+		RotationSeconds:  state.RotationSeconds,
+		RotationYears:    state.RotationYears,
+	}
+
+	if identity.rotationUnitsNull() && identity.RotationCron.IsNull() && identity.RotationDuration.IsNull() && !state.RotationRFC3339.IsNull() {
+		identity.RotationRFC3339 = types.StringValue(state.RotationRFC3339.ValueString())
+	}
+
+	return identity
+}
+
+func (m timeRotatingIdentityModel) rotationUnitsNull() bool {
+	return m.RotationYears.IsNull() &&
+		m.RotationMonths.IsNull() &&
+		m.RotationDays.IsNull() &&
+		m.RotationHours.IsNull() &&
+		m.RotationMinutes.IsNull() &&
+		m.RotationSeconds.IsNull()
+}
+
+// importID returns the identity in one of the import ID formats, so that both
+// are validated the same way.
+func (m timeRotatingIdentityModel) importID() string {
+	switch {
+	case m.RotationCron.ValueString() != "":
+		return m.RFC3339.ValueString() + "," + m.RotationCron.ValueString()
+	case m.RotationDuration.ValueString() != "":
+		return m.RFC3339.ValueString() + "," + m.RotationDuration.ValueString()
+	case m.rotationUnitsNull() && m.RotationRFC3339.ValueString() != "":
+		return m.RFC3339.ValueString() + "," + m.RotationRFC3339.ValueString()
+	}
+
+	return strings.Join([]string{
+		m.RFC3339.ValueString(),
+		importIDPart(m.RotationYears),
+		importIDPart(m.RotationMonths),
+		importIDPart(m.RotationDays),
+		importIDPart(m.RotationHours),
+		importIDPart(m.RotationMinutes),
+		importIDPart(m.RotationSeconds),
+	}, ",")
+}
+
+// timeRotatingImportKeys are the keys of the key=value import ID syntax.
+var timeRotatingImportKeys = []string{
+	"base",
+	"rotation_years",
+	"rotation_months",
+	"rotation_days",
+	"rotation_hours",
+	"rotation_minutes",
+	"rotation_seconds",
+	"rotation_duration",
+	"rotation_cron",
+	"rotation_rfc3339",
+}
+
+// timeRotatingKeyValueImportID converts an import ID in the key=value syntax
+// to the matching positional import ID. Exactly one of the rotation unit keys,
+// rotation_duration, rotation_cron or rotation_rfc3339 must be given, as the
+// positional formats cannot combine them.
+func timeRotatingKeyValueImportID(ctx context.Context, id string) (string, types.Map, diag.Diagnostics) {
+	parts, triggers, diags := keyValueImportIDParts(ctx, id, timeRotatingImportKeys)
+	if diags.HasError() {
+		return "", triggers, diags
+	}
+
+	base, units := parts[0], parts[1:7]
+	duration, cron, rotationRFC3339 := parts[7], parts[8], parts[9]
+
+	var rotations []string
+
+	if strings.Join(units, "") != "" {
+		rotations = append(rotations, strings.Join(units, ","))
+	}
+
+	for _, rotation := range []string{duration, cron, rotationRFC3339} {
+		if rotation != "" {
+			rotations = append(rotations, rotation)
+		}
+	}
+
+	if base == "" || len(rotations) != 1 {
+		diags.AddError(
+			"Unexpected Format of ID",
+			fmt.Sprintf("Unexpected format of ID (%q), expected base=BASETIMESTAMP and either rotation_duration, rotation_cron, rotation_rfc3339 or at least one of %s", id, strings.Join(timeRotatingImportKeys[1:7], ", ")))
+
+		return "", triggers, diags
+	}
+
+	// The positional format is chosen by the value, so the value has to match
+	// its key.
+	if duration != "" && !isDurationImportIdPart(duration) {
+		diags.AddError(
+			"Import time rotating error",
+			fmt.Sprintf("The rotation_duration value (%q) could not be parsed as a Go or ISO 8601 duration.", duration),
+		)
+
+		return "", triggers, diags
+	}
+
+	if cron != "" && !isCronImportIdPart(cron) {
+		diags.AddError(
+			"Import time rotating error",
+			fmt.Sprintf("The rotation_cron value (%q) could not be parsed as a cron expression.", cron),
+		)
+
+		return "", triggers, diags
+	}
+
+	return base + "," + rotations[0], triggers, diags
+}
+
+// rotatesInPlace reports whether an expired resource is rotated with an
+// update instead of being removed from state and recreated.
+func rotatesInPlace(model *timeRotatingModelV2) bool {
+	return model.RotationMode.ValueString() == rotationModeInPlace || model.HistorySize.ValueInt64() > 0
+}
+
+// rotatedInPlace reports whether the plan rotates the resource in place, which
+// ModifyPlan marks by increasing generation from the state value, or from 1
+// if the state has none.
+func rotatedInPlace(state *timeRotatingModelV2, plan *timeRotatingModelV2) bool {
+	generation := state.Generation.ValueInt64()
+
+	if state.Generation.IsNull() {
+		generation = 1
+	}
+
+	return plan.Generation.ValueInt64() > generation
+}
+
+// maintenanceWindowOpen reports whether now is inside an opening of the
+// configured maintenance_window. It is always open when no maintenance window
+// is configured or the window is not known yet.
+func maintenanceWindowOpen(ctx context.Context, model *timeRotatingModelV2, now time.Time) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.MaintenanceWindow.IsNull() || model.MaintenanceWindow.IsUnknown() {
+		return true, diags
+	}
+
+	var maintenanceWindow timeRotatingMaintenanceWindowModel
+
+	diags.Append(model.MaintenanceWindow.As(ctx, &maintenanceWindow, basetypes.ObjectAsOptions{})...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if maintenanceWindow.DaysOfWeek.IsUnknown() ||
+		maintenanceWindow.Duration.IsUnknown() ||
+		maintenanceWindow.StartTime.IsUnknown() ||
+		maintenanceWindow.Timezone.IsUnknown() {
+		return true, diags
+	}
+
+	timezone := maintenanceWindow.Timezone
+
+	if timezone.IsNull() {
+		timezone = model.Timezone
+	}
+
+	location, timezoneDiags := loadTimezone(timezone)
+
+	diags.Append(timezoneDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if location != nil {
+		now = now.In(location)
+	} else {
+		now = now.UTC()
+	}
+
+	var daysOfWeek []string
+
+	diags.Append(maintenanceWindow.DaysOfWeek.ElementsAs(ctx, &daysOfWeek, false)...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var window calendar.Window
+
+	for _, dayOfWeek := range daysOfWeek {
+		weekday, err := calendar.ParseWeekday(dayOfWeek)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("maintenance_window").AtName("days_of_week"),
+				"Invalid Weekday",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return false, diags
+		}
+
+		window.Days = append(window.Days, weekday)
+	}
+
+	hour, minute, err := calendar.ParseTimeOfDay(maintenanceWindow.StartTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("maintenance_window").AtName("start_time"),
+			"Invalid Time Of Day",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return false, diags
+	}
+
+	window.Hour = hour
+	window.Minute = minute
+
+	window.Duration, err = calendar.ParsePeriod(maintenanceWindow.Duration.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("maintenance_window").AtName("duration"),
+			"Invalid Duration",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return false, diags
+	}
+
+	return window.Contains(now), diags
+}
+
+func previousRotationsFromList(ctx context.Context, list types.List) ([]timeRotatingPreviousRotationModel, diag.Diagnostics) {
+	var previousRotations []timeRotatingPreviousRotationModel
+
+	if list.IsNull() || list.IsUnknown() {
+		return previousRotations, nil
+	}
+
+	diags := list.ElementsAs(ctx, &previousRotations, false)
+
+	return previousRotations, diags
+}
+
+// previousRotationsToList returns the most recent previous rotations up to the
+// history size, or a null list when no history size is configured.
+func previousRotationsToList(ctx context.Context, previousRotations []timeRotatingPreviousRotationModel, historySize types.Int64) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes}
+
+	if historySize.ValueInt64() <= 0 {
+		return types.ListNull(elementType), nil
+	}
+
+	if int64(len(previousRotations)) > historySize.ValueInt64() {
+		previousRotations = previousRotations[:historySize.ValueInt64()]
+	}
+
+	if previousRotations == nil {
+		previousRotations = []timeRotatingPreviousRotationModel{}
+	}
+
+	return types.ListValueFrom(ctx, elementType, previousRotations)
+}
+
+func setRotationValues(plan *timeRotatingModelV2, timestamp time.Time) diag.Diagnostics {
+	var rotationTimestamp time.Time
+
+	location, diags := loadTimezone(plan.Timezone)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// The base timestamp keeps its own offset for the rfc3339 and id attributes,
+	// while calendar arithmetic is done in the configured time zone so that
+	// adding days, months or years keeps the same local time across daylight
+	// saving time changes.
+	base := timestamp
+
+	if location != nil {
+		base = timestamp.In(location)
+	}
+
+	// A known rotation_rfc3339 is either configured or the value already
+	// calculated from the other rotation arguments during planning.
+	if plan.RotationRFC3339.ValueString() != "" {
 		rotationTimestamp, diags = plan.RotationRFC3339.ValueRFC3339Time()
+
+		if plan.AppliedJitter.IsUnknown() {
+			plan.AppliedJitter = types.StringNull()
+		}
+
+		if plan.NextRotationRFC3339.IsUnknown() {
+			plan.NextRotationRFC3339 = timetypes.NewRFC3339Null()
+		}
+	} else {
+		var scheduledTimestamp time.Time
+
+		rotationTimestamp, scheduledTimestamp, plan.AppliedJitter, diags = calculateRotation(plan, base)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		plan.NextRotationRFC3339 = timetypes.NewRFC3339Null()
+
+		// The next rotation assumes that the resource is rotated exactly at the
+		// rotation timestamp. It is calculated from the rotation timestamp
+		// before the jitter was added, so that the jitter is added once instead
+		// of accumulating with every rotation.
+		if !rotationTimestamp.IsZero() {
+			var nextRotationTimestamp time.Time
+
+			nextRotationTimestamp, _, _, diags = calculateRotation(plan, scheduledTimestamp)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			if nextRotationTimestamp.After(rotationTimestamp) {
+				plan.NextRotationRFC3339 = timetypes.NewRFC3339TimeValue(nextRotationTimestamp)
+			}
+		}
+	}
+
+	utcTimestamp := rotationTimestamp
+	localTimestamp := rotationTimestamp
+
+	if location != nil && !rotationTimestamp.IsZero() {
+		utcTimestamp = rotationTimestamp.UTC()
+		localTimestamp = rotationTimestamp.In(location)
+	}
+
+	plan.RotationRFC3339 = timetypes.NewRFC3339TimeValue(rotationTimestamp)
+	plan.Year = types.Int64Value(int64(utcTimestamp.Year()))
+	plan.Month = types.Int64Value(int64(utcTimestamp.Month()))
+	plan.Day = types.Int64Value(int64(utcTimestamp.Day()))
+	plan.Hour = types.Int64Value(int64(utcTimestamp.Hour()))
+	plan.Minute = types.Int64Value(int64(utcTimestamp.Minute()))
+	plan.Second = types.Int64Value(int64(utcTimestamp.Second()))
+	plan.LocalYear = types.Int64Value(int64(localTimestamp.Year()))
+	plan.LocalMonth = types.Int64Value(int64(localTimestamp.Month()))
+	plan.LocalDay = types.Int64Value(int64(localTimestamp.Day()))
+	plan.LocalHour = types.Int64Value(int64(localTimestamp.Hour()))
+	plan.LocalMinute = types.Int64Value(int64(localTimestamp.Minute()))
+	plan.LocalSecond = types.Int64Value(int64(localTimestamp.Second()))
+	plan.RFC3339 = timetypes.NewRFC3339TimeValue(timestamp)
+	plan.Unix = types.Int64Value(rotationTimestamp.Unix())
+	plan.ID = timetypes.NewRFC3339TimeValue(timestamp)
+	plan.BaseComponents = timeRotatingComponents(timestamp)
+	plan.RotationComponents = timeRotatingComponents(rotationTimestamp)
+
+	return diags
+}
+
+// calculateRotation returns the rotation timestamp for the base timestamp from
+// the rotation arguments, along with the rotation timestamp before the jitter
+// was added and the applied jitter.
+func calculateRotation(plan *timeRotatingModelV2, base time.Time) (time.Time, time.Time, types.String, diag.Diagnostics) {
+	var rotationTimestamp time.Time
+	var diags diag.Diagnostics
+
+	if plan.AnchorRFC3339.ValueString() != "" {
+		rotationTimestamp, diags = anchoredRotation(plan, base)
+	} else {
+		rotationTimestamp, diags = addRotationUnits(plan, base)
 	}
 
-	if plan.RotationYears.ValueInt64() != 0 {
-		rotationTimestamp = timestamp.AddDate(int(plan.RotationYears.ValueInt64()), 0, 0)
+	if diags.HasError() {
+		return time.Time{}, time.Time{}, types.StringNull(), diags
 	}
 
-	plan.RotationRFC3339 = timetypes.NewRFC3339TimeValue(rotationTimestamp)
-	plan.Year = types.Int64Value(int64(rotationTimestamp.Year()))
-	plan.Month = types.Int64Value(int64(rotationTimestamp.Month()))
-	plan.Day = types.Int64Value(int64(rotationTimestamp.Day()))
-	plan.Hour = types.Int64Value(int64(rotationTimestamp.Hour()))
-	plan.Minute = types.Int64Value(int64(rotationTimestamp.Minute()))
-	plan.Second = types.Int64Value(int64(rotationTimestamp.Second()))
+	businessDays, diags := parseBusinessDays(plan.WeekendDays, plan.Holidays)
+
+	if diags.HasError() {
+		return time.Time{}, time.Time{}, types.StringNull(), diags
+	}
+
+	if plan.RotationBusinessDays.ValueInt64() > 0 {
+		if rotationTimestamp.IsZero() {
+			rotationTimestamp = base
+		}
+
+		rotationTimestamp = businessDays.Add(rotationTimestamp, int(plan.RotationBusinessDays.ValueInt64()))
+	}
+
+	if plan.RotationCron.ValueString() != "" {
+		rotationTimestamp, diags = nextCronRotation(plan.RotationCron.ValueString(), base, rotationTimestamp)
+	} else if plan.AlignTo.ValueString() != "" {
+		rotationTimestamp, diags = alignRotation(plan.AlignTo.ValueString(), plan.WeekStart.ValueString(), base, rotationTimestamp)
+	}
+
+	if diags.HasError() {
+		return time.Time{}, time.Time{}, types.StringNull(), diags
+	}
+
+	scheduledTimestamp := rotationTimestamp
+	appliedJitter := types.StringNull()
+
+	if plan.RotationJitter.ValueString() != "" && !rotationTimestamp.IsZero() {
+		var jitter time.Duration
+
+		jitter, diags = rotationJitter(plan.RotationJitter.ValueString(), plan.JitterSeed.ValueString(), rotationTimestamp)
+
+		if diags.HasError() {
+			return time.Time{}, time.Time{}, types.StringNull(), diags
+		}
+
+		rotationTimestamp = rotationTimestamp.Add(jitter)
+		appliedJitter = types.StringValue(jitter.String())
+	}
+
+	if !rotationTimestamp.IsZero() &&
+		(!plan.RotationBusinessDays.IsNull() || !plan.WeekendDays.IsNull() || !plan.Holidays.IsNull()) {
+		rotationTimestamp = businessDays.Next(rotationTimestamp)
+	}
+
+	deadline, diags := rotationDeadline(plan, base)
+
+	if diags.HasError() {
+		return time.Time{}, time.Time{}, types.StringNull(), diags
+	}
+
+	if !deadline.IsZero() && (rotationTimestamp.IsZero() || deadline.Before(rotationTimestamp)) {
+		return deadline, deadline, types.StringNull(), diags
+	}
+
+	return rotationTimestamp, scheduledTimestamp, appliedJitter, diags
+}
+
+// parseBusinessDays returns the business days for the weekend_days and
+// holidays lists, which default to a Saturday and Sunday weekend without
+// holidays when null.
+func parseBusinessDays(weekendDays types.List, holidays types.List) (calendar.BusinessDays, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var weekend []time.Weekday
+	var dates []string
+
+	if !weekendDays.IsNull() && !weekendDays.IsUnknown() {
+		weekend = []time.Weekday{}
+
+		for _, element := range weekendDays.Elements() {
+			weekendDay, ok := element.(types.String)
+
+			if !ok || weekendDay.IsNull() || weekendDay.IsUnknown() {
+				continue
+			}
+
+			weekday, err := calendar.ParseWeekday(weekendDay.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("weekend_days"),
+					"Invalid Weekday",
+					fmt.Sprintf("Original Error: %s", err),
+				)
+				return calendar.BusinessDays{}, diags
+			}
+
+			weekend = append(weekend, weekday)
+		}
+	}
+
+	if !holidays.IsNull() && !holidays.IsUnknown() {
+		for _, element := range holidays.Elements() {
+			holiday, ok := element.(types.String)
+
+			if !ok || holiday.IsNull() || holiday.IsUnknown() {
+				continue
+			}
+
+			dates = append(dates, holiday.ValueString())
+		}
+	}
+
+	businessDays, err := calendar.NewBusinessDays(weekend, dates)
+	if err != nil {
+		diags.AddError(
+			"Invalid Business Days",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+	}
+
+	return businessDays, diags
+}
+
+// rotationDeadline returns the earliest rotate_before timestamp minus
+// lead_time that is after the base timestamp, or the zero time if there is
+// none.
+func rotationDeadline(plan *timeRotatingModelV2, base time.Time) (time.Time, diag.Diagnostics) {
+	var deadline time.Time
+	var diags diag.Diagnostics
+
+	if plan.RotateBefore.IsNull() || plan.RotateBefore.IsUnknown() {
+		return deadline, diags
+	}
+
+	var leadTime calendar.Period
+
+	if plan.LeadTime.ValueString() != "" {
+		var err error
+
+		leadTime, err = calendar.ParsePeriod(plan.LeadTime.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("lead_time"),
+				"Invalid Duration",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return deadline, diags
+		}
+	}
+
+	for _, element := range plan.RotateBefore.Elements() {
+		rotateBefore, ok := element.(timetypes.RFC3339)
+
+		if !ok || rotateBefore.ValueString() == "" {
+			continue
+		}
+
+		timestamp, timestampDiags := rotateBefore.ValueRFC3339Time()
+
+		diags.Append(timestampDiags...)
+
+		if diags.HasError() {
+			return time.Time{}, diags
+		}
+
+		candidate := leadTime.Negate().AddTo(timestamp.In(base.Location()))
+
+		if candidate.After(base) && (deadline.IsZero() || candidate.Before(deadline)) {
+			deadline = candidate
+		}
+	}
+
+	return deadline, diags
+}
+
+// setRotationValuesUnknown keeps the base timestamp and marks every value
+// calculated from the rotation arguments as unknown.
+func setRotationValuesUnknown(plan *timeRotatingModelV2, timestamp time.Time) {
+	plan.RotationRFC3339 = timetypes.NewRFC3339Unknown()
+	plan.NextRotationRFC3339 = timetypes.NewRFC3339Unknown()
+	plan.AppliedJitter = types.StringUnknown()
+	plan.Year = types.Int64Unknown()
+	plan.Month = types.Int64Unknown()
+	plan.Day = types.Int64Unknown()
+	plan.Hour = types.Int64Unknown()
+	plan.Minute = types.Int64Unknown()
+	plan.Second = types.Int64Unknown()
+	plan.LocalYear = types.Int64Unknown()
+	plan.LocalMonth = types.Int64Unknown()
+	plan.LocalDay = types.Int64Unknown()
+	plan.LocalHour = types.Int64Unknown()
+	plan.LocalMinute = types.Int64Unknown()
+	plan.LocalSecond = types.Int64Unknown()
+	plan.Unix = types.Int64Unknown()
 	plan.RFC3339 = timetypes.NewRFC3339TimeValue(timestamp)
-	plan.Unix = types.Int64Value(rotationTimestamp.Unix())
 	plan.ID = timetypes.NewRFC3339TimeValue(timestamp)
+	plan.BaseComponents = timeRotatingComponents(timestamp)
+	plan.RotationComponents = types.ObjectUnknown(timeRotatingComponentsAttrTypes)
+
+	plan.InLeadWindow = types.BoolNull()
+
+	if !plan.LeadTime.IsNull() {
+		plan.InLeadWindow = types.BoolUnknown()
+	}
+
+	plan.SecondsUntilRotation = types.Int64Null()
+
+	if plan.TrackRotation.ValueBool() {
+		plan.SecondsUntilRotation = types.Int64Unknown()
+	}
+}
+
+// isFullyKnown reports whether a list and all of its elements are known.
+func isFullyKnown(list types.List) bool {
+	if list.IsUnknown() {
+		return false
+	}
+
+	for _, element := range list.Elements() {
+		if element.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setLeadWindow sets whether the current time is within lead_time of the
+// rotation timestamp.
+func setLeadWindow(plan *timeRotatingModelV2, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plan.InLeadWindow = types.BoolNull()
+
+	if plan.LeadTime.ValueString() == "" || plan.RotationRFC3339.ValueString() == "" {
+		return diags
+	}
+
+	rotationTimestamp, diags := plan.RotationRFC3339.ValueRFC3339Time()
+
+	if diags.HasError() || rotationTimestamp.IsZero() {
+		return diags
+	}
+
+	leadTime, err := calendar.ParsePeriod(plan.LeadTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("lead_time"),
+			"Invalid Duration",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return diags
+	}
+
+	plan.InLeadWindow = types.BoolValue(!now.Before(leadTime.Negate().AddTo(rotationTimestamp)))
+
+	return diags
+}
+
+// setSecondsUntilRotation sets seconds_until_rotation from the rotation
+// timestamp if track_rotation is enabled.
+func setSecondsUntilRotation(plan *timeRotatingModelV2, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plan.SecondsUntilRotation = types.Int64Null()
+
+	if !plan.TrackRotation.ValueBool() || plan.RotationRFC3339.ValueString() == "" {
+		return diags
+	}
+
+	rotationTimestamp, diags := plan.RotationRFC3339.ValueRFC3339Time()
+
+	if diags.HasError() || rotationTimestamp.IsZero() {
+		return diags
+	}
+
+	plan.SecondsUntilRotation = types.Int64Value(int64(rotationTimestamp.Sub(now) / time.Second))
 
 	return diags
 }
 
-func parseTwoPartId(idParts []string) (timeRotatingModelV0, error) {
+// addRotationUnits returns the base timestamp with all configured rotation
+// units and the rotation duration added together in calendar order, from
+// years down to seconds, or the zero time when none are configured.
+func addRotationUnits(plan *timeRotatingModelV2, timestamp time.Time) (time.Time, diag.Diagnostics) {
+	period, diags := rotationPeriod(plan)
+
+	if diags.HasError() || period.IsZero() {
+		return time.Time{}, diags
+	}
+
+	return period.AddTo(timestamp), diags
+}
+
+// anchoredRotation returns the first anchor_rfc3339 plus a whole number of
+// rotation periods after the timestamp.
+func anchoredRotation(plan *timeRotatingModelV2, timestamp time.Time) (time.Time, diag.Diagnostics) {
+	period, diags := rotationPeriod(plan)
+
+	if diags.HasError() || period.IsZero() {
+		return time.Time{}, diags
+	}
+
+	anchor, anchorDiags := plan.AnchorRFC3339.ValueRFC3339Time()
+
+	diags.Append(anchorDiags...)
+
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return calendar.NextAnchored(anchor.In(timestamp.Location()), period, timestamp), diags
+}
+
+// rotationPeriod returns the sum of the rotation units and rotation_duration.
+func rotationPeriod(plan *timeRotatingModelV2) (calendar.Period, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	period := calendar.Period{
+		Years:  int(plan.RotationYears.ValueInt64()),
+		Months: int(plan.RotationMonths.ValueInt64()),
+		Days:   int(plan.RotationDays.ValueInt64()),
+		Duration: time.Duration(plan.RotationHours.ValueInt64())*time.Hour +
+			time.Duration(plan.RotationMinutes.ValueInt64())*time.Minute +
+			time.Duration(plan.RotationSeconds.ValueInt64())*time.Second,
+	}
+
+	if plan.RotationDuration.ValueString() != "" {
+		rotationDuration, err := calendar.ParsePeriod(plan.RotationDuration.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("rotation_duration"),
+				"Invalid Duration",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return calendar.Period{}, diags
+		}
+
+		period.Years += rotationDuration.Years
+		period.Months += rotationDuration.Months
+		period.Days += rotationDuration.Days
+		period.Duration += rotationDuration.Duration
+	}
+
+	return period, diags
+}
+
+// rotationJitter returns the deterministic jitter for the seed, up to the
+// maximum rotation jitter after the rotation timestamp.
+func rotationJitter(maxJitter string, seed string, rotationTimestamp time.Time) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	period, err := calendar.ParsePeriod(maxJitter)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("rotation_jitter"),
+			"Invalid Duration",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return 0, diags
+	}
+
+	return calendar.Jitter(seed, period.AddTo(rotationTimestamp).Sub(rotationTimestamp)), diags
+}
+
+// loadTimezone returns the location of the configured time zone, or nil when
+// no time zone is configured.
+func loadTimezone(timezone types.String) (*time.Location, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if timezone.ValueString() == "" {
+		return nil, diags
+	}
+
+	location, err := time.LoadLocation(timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timezone"),
+			"Invalid Time Zone",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+	}
+
+	return location, diags
+}
+
+// nextCronRotation returns the next occurrence of the cron schedule after the
+// rotation timestamp calculated from the other rotation arguments, or after
+// the base timestamp when none are configured.
+func nextCronRotation(cron string, timestamp time.Time, rotationTimestamp time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schedule, err := calendar.ParseCron(cron)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("rotation_cron"),
+			"Invalid Cron Expression",
+			"The rotation_cron expression that was supplied could not be parsed.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return time.Time{}, diags
+	}
+
+	from := timestamp
+
+	if !rotationTimestamp.IsZero() {
+		from = rotationTimestamp
+	}
+
+	next := schedule.Next(from)
+
+	if next.IsZero() {
+		diags.AddAttributeError(
+			path.Root("rotation_cron"),
+			"Invalid Cron Expression",
+			fmt.Sprintf("The rotation_cron expression (%q) has no occurrence after %s.", cron, from.Format(time.RFC3339)),
+		)
+		return time.Time{}, diags
+	}
+
+	return next, diags
+}
+
+// alignRotation moves the rotation timestamp calculated from the other rotation
+// arguments, or the base timestamp when none are configured, forward to the
+// start of the next calendar period.
+func alignRotation(alignTo string, weekStart string, timestamp time.Time, rotationTimestamp time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unit, err := calendar.ParseUnit(alignTo)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("align_to"),
+			"Invalid Calendar Unit",
+			fmt.Sprintf("Original Error: %s", err),
+		)
+		return time.Time{}, diags
+	}
+
+	startOfWeek := time.Monday
+
+	if weekStart != "" {
+		startOfWeek, err = calendar.ParseWeekday(weekStart)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("week_start"),
+				"Invalid Weekday",
+				fmt.Sprintf("Original Error: %s", err),
+			)
+			return time.Time{}, diags
+		}
+	}
+
+	from := timestamp
+
+	if !rotationTimestamp.IsZero() {
+		from = rotationTimestamp
+	}
+
+	return calendar.Ceil(from, unit, startOfWeek), diags
+}
+
+func parseCronId(baseRfc3339 string, cron string) (timeRotatingModelV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
+	if err != nil {
+		diags.AddError(
+			"Import time rotating error",
+			"The timestamp that was supplied could not be parsed as RFC3339.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return timeRotatingModelV2{}, diags
+	}
+
+	state := timeRotatingModelV2{
+		RotationCron:    types.StringValue(cron),
+		RotationYears:   types.Int64Null(),
+		RotationMonths:  types.Int64Null(),
+		RotationDays:    types.Int64Null(),
+		RotationHours:   types.Int64Null(),
+		RotationMinutes: types.Int64Null(),
+		RotationSeconds: types.Int64Null(),
+	}
+
+	diags.Append(setRotationValues(&state, timestamp)...)
+
+	return state, diags
+}
+
+func parseTwoPartId(idParts []string) (timeRotatingModelV2, error) {
 
 	baseRfc3339 := idParts[0]
 	rotationRfc3339 := idParts[1]
 
 	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
 	rotationTimestamp, err := time.Parse(time.RFC3339, rotationRfc3339)
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
-	return timeRotatingModelV0{
-		Year:            types.Int64Value(int64(rotationTimestamp.Year())),
-		Month:           types.Int64Value(int64(rotationTimestamp.Month())),
-		Day:             types.Int64Value(int64(rotationTimestamp.Day())),
-		Hour:            types.Int64Value(int64(rotationTimestamp.Hour())),
-		Minute:          types.Int64Value(int64(rotationTimestamp.Minute())),
-		Second:          types.Int64Value(int64(rotationTimestamp.Second())),
+	state := timeRotatingModelV2{
 		RotationRFC3339: timetypes.NewRFC3339TimeValue(rotationTimestamp),
 		RotationYears:   types.Int64Null(),
 		RotationMonths:  types.Int64Null(),
 		RotationDays:    types.Int64Null(),
 		RotationHours:   types.Int64Null(),
 		RotationMinutes: types.Int64Null(),
-		RFC3339:         timetypes.NewRFC3339TimeValue(timestamp),
-		Unix:            types.Int64Value(rotationTimestamp.Unix()),
-		ID:              timetypes.NewRFC3339TimeValue(timestamp),
-	}, nil
+		RotationSeconds: types.Int64Null(),
+	}
+
+	if diags := setRotationValues(&state, timestamp); diags.HasError() {
+		return timeRotatingModelV2{}, fmt.Errorf("could not calculate rotation timestamp: %s", diags.Errors()[0].Detail())
+	}
+
+	return state, nil
 }
 
-func parseMultiplePartId(idParts []string) (timeRotatingModelV0, error) {
+func parseDurationId(idParts []string) (timeRotatingModelV2, error) {
 	baseRfc3339 := idParts[0]
+	rotationDuration := idParts[1]
 
-	rotationYears, err := rotationToInt64(idParts[1])
+	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
-	rotationMonths, err := rotationToInt64(idParts[2])
-	if err != nil {
-		return timeRotatingModelV0{}, err
+	state := timeRotatingModelV2{
+		RotationDuration: types.StringValue(rotationDuration),
+		RotationYears:    types.Int64Null(),
+		RotationMonths:   types.Int64Null(),
+		RotationDays:     types.Int64Null(),
+		RotationHours:    types.Int64Null(),
+		RotationMinutes:  types.Int64Null(),
+		RotationSeconds:  types.Int64Null(),
 	}
 
-	rotationDays, err := rotationToInt64(idParts[3])
-	if err != nil {
-		return timeRotatingModelV0{}, err
+	if diags := setRotationValues(&state, timestamp); diags.HasError() {
+		return timeRotatingModelV2{}, fmt.Errorf("could not calculate rotation timestamp: %s", diags.Errors()[0].Detail())
 	}
 
-	rotationHours, err := rotationToInt64(idParts[4])
+	return state, nil
+}
+
+func parseMultiplePartId(idParts []string) (timeRotatingModelV2, error) {
+	baseRfc3339 := idParts[0]
+
+	rotationYears, err := rotationToInt64(idParts[1])
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
-	rotationMinutes, err := rotationToInt64(idParts[5])
+	rotationMonths, err := rotationToInt64(idParts[2])
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
-	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
+	rotationDays, err := rotationToInt64(idParts[3])
 	if err != nil {
-		return timeRotatingModelV0{}, err
+		return timeRotatingModelV2{}, err
 	}
 
-	var rotationTimestamp time.Time
-
-	if !rotationDays.IsNull() && rotationDays.ValueInt64() > 0 {
-		rotationTimestamp = timestamp.AddDate(0, 0, int(rotationDays.ValueInt64()))
+	rotationHours, err := rotationToInt64(idParts[4])
+	if err != nil {
+		return timeRotatingModelV2{}, err
 	}
 
-	if !rotationHours.IsNull() && rotationHours.ValueInt64() > 0 {
-		hours := time.Duration(rotationHours.ValueInt64()) * time.Hour
-		rotationTimestamp = timestamp.Add(hours)
+	rotationMinutes, err := rotationToInt64(idParts[5])
+	if err != nil {
+		return timeRotatingModelV2{}, err
 	}
 
-	if !rotationMinutes.IsNull() && rotationMinutes.ValueInt64() > 0 {
-		minutes := time.Duration(rotationMinutes.ValueInt64()) * time.Minute
-		rotationTimestamp = timestamp.Add(minutes)
-	}
+	rotationSeconds := types.Int64Null()
 
-	if !rotationMonths.IsNull() && rotationMonths.ValueInt64() > 0 {
-		rotationTimestamp = timestamp.AddDate(0, int(rotationMonths.ValueInt64()), 0)
+	if len(idParts) == 7 {
+		rotationSeconds, err = rotationToInt64(idParts[6])
+		if err != nil {
+			return timeRotatingModelV2{}, err
+		}
 	}
 
-	if !rotationYears.IsNull() && rotationYears.ValueInt64() > 0 {
-		rotationTimestamp = timestamp.AddDate(int(rotationYears.ValueInt64()), 0, 0)
+	timestamp, err := time.Parse(time.RFC3339, baseRfc3339)
+	if err != nil {
+		return timeRotatingModelV2{}, err
 	}
 
-	state := timeRotatingModelV0{
-		Year:            types.Int64Value(int64(rotationTimestamp.Year())),
-		Month:           types.Int64Value(int64(rotationTimestamp.Month())),
-		Day:             types.Int64Value(int64(rotationTimestamp.Day())),
-		Hour:            types.Int64Value(int64(rotationTimestamp.Hour())),
-		Minute:          types.Int64Value(int64(rotationTimestamp.Minute())),
-		Second:          types.Int64Value(int64(rotationTimestamp.Second())),
-		RotationRFC3339: timetypes.NewRFC3339TimeValue(rotationTimestamp),
+	state := timeRotatingModelV2{
 		RotationYears:   rotationYears,
 		RotationMonths:  rotationMonths,
 		RotationDays:    rotationDays,
 		RotationHours:   rotationHours,
 		RotationMinutes: rotationMinutes,
-		RFC3339:         timetypes.NewRFC3339TimeValue(timestamp),
-		Unix:            types.Int64Value(rotationTimestamp.Unix()),
-		ID:              timetypes.NewRFC3339TimeValue(timestamp),
+		RotationSeconds: rotationSeconds,
+	}
+
+	if diags := setRotationValues(&state, timestamp); diags.HasError() {
+		return timeRotatingModelV2{}, fmt.Errorf("could not calculate rotation timestamp: %s", diags.Errors()[0].Detail())
 	}
 
 	return state, nil
 }
 
+// isCronImportIdPart reports whether the import ID part is a cron expression
+// rather than an RFC3339 timestamp or rotation number, neither of which can
+// contain spaces or start with a macro.
+func isCronImportIdPart(idPart string) bool {
+	return strings.Contains(strings.TrimSpace(idPart), " ") || strings.HasPrefix(idPart, "@")
+}
+
+// isDurationImportIdPart reports whether the import ID part is a Go or
+// ISO 8601 duration rather than an RFC3339 timestamp.
+func isDurationImportIdPart(idPart string) bool {
+	_, err := calendar.ParsePeriod(idPart)
+
+	return err == nil
+}
+
 func rotationToInt64(rotationStr string) (types.Int64, error) {
 	rotation := types.Int64Null()
 
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -25,8 +27,10 @@ func TestAccTimeRotating_Triggers(t *testing.T) {
 	t.Parallel()
 	resourceName := "time_rotating.test"
 
+	mockClock := timetesting.NewFakeClock(time.Now().UTC())
+
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
 		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
@@ -51,9 +55,9 @@ func TestAccTimeRotating_Triggers(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"triggers"},
 			},
 			{
-				// Ensures a time difference when running unit tests in CI
+				// Ensures a time difference between the base timestamps
 				PreConfig: func() {
-					time.Sleep(time.Duration(1) * time.Second)
+					mockClock.Increment(time.Second)
 				},
 				Config: testAccConfigTimeRotatingTriggers1("key1", "value1updated"),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -1189,244 +1193,1711 @@ func TestAccTimeRotating_ConfiguredRFC3339_RotationDays_ToRotationMonths(t *test
 	})
 }
 
-// When the resource is being updated, the "rotation_rfc3339" value
-// is computed during ModifyPlan(). If any of the "rotation_" attributes
-// are unknown during the plan, then an incorrect value will be calculated
-// for "rotation_rfc3339" during the initial plan which will differ from
-// the final plan, causing Terraform core to throw an error
-// Ref: https://github.com/hashicorp/terraform-provider-time/issues/227
-func TestAccTimeRotating_UpdateUnknownValue(t *testing.T) {
+func TestAccTimeRotating_RotationCron_basic(t *testing.T) {
 	t.Parallel()
 	resourceName := "time_rotating.test"
 
-	baseTimestamp := time.Now().UTC()
-	rotationDays := 7
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
 
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
 		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccConfigTimeRotatingRFC3339RotationDays(baseTimestamp.Format(time.RFC3339), rotationDays),
+				Config: testAccConfigTimeRotatingRFC3339RotationCron(baseTimestamp.Format(time.RFC3339), "0 3 * 1,4,7,10 MON#1"),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
 						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
-						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
-						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Int64Exact(int64(rotationDays))),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_cron"), knownvalue.StringExact("0 3 * 1,4,7,10 MON#1")),
 					},
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(baseTimestamp.AddDate(0, 0, rotationDays).Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-04-01T03:00:00Z")),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Int64Exact(int64(rotationDays))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("year"), knownvalue.Int64Exact(2030)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("month"), knownvalue.Int64Exact(4)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("day"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(3)),
 				},
 			},
 			{
-				Config: fmt.Sprintf(`resource "time_static" "unknown" {}
-
-					resource "time_rotating" "test" {
-  							rotation_days   = time_static.unknown.day
-  							rfc3339         = %q
-						}`, baseTimestamp.Format(time.RFC3339)),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimeRotatingImportStateIdFunc(),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigTimeRotatingRFC3339RotationCron(baseTimestamp.Format(time.RFC3339), "CRON_TZ=Europe/Berlin 0 3 * 1,4,7,10 MON#1"),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
-						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_days")),
-						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("0001-01-01T00:00:00Z")),
-						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-04-01T01:00:00Z")),
 					},
 				},
-				ExpectError: regexp.MustCompile(`.*Error: Provider produced inconsistent final plan`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-04-01T01:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+				},
 			},
 		},
 	})
 }
 
-// The "time_rotating" resource predates the `replace_triggered_by`
-// lifestyle argument introduced in Terraform v1.2.0. The `replace_triggered_by` argument looks
-// for an update or replacement of the supplied resource instance. Because the "time_rotating" rotation
-// checking logic is run during ReadResource() and the resource is removed from state,
-// a rotation is considered to be a creation of a new resource rather than an update or replacement.
-// Ref: https://github.com/hashicorp/terraform-provider-time/issues/118
-func TestAccTimeRotating_LifecycleReplaceTriggeredBy(t *testing.T) {
+func TestAccTimeRotating_RotationCronWithRotationDays(t *testing.T) {
 	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
+
 	resource.UnitTest(t, resource.TestCase{
-		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
-			// "terraform_data" resource is only available in Terraform v1.4.0 and above.
-			tfversion.SkipBelow(tfversion.Version1_4_0),
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339       = %q
+  rotation_days = 90
+  rotation_cron = "0 3 * 1,4,7,10 MON#1"
+}
+`, baseTimestamp.Format(time.RFC3339)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// 90 days after the base timestamp is 2030-04-17, so the first Monday of the next quarter is used.
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-07-01T03:00:00Z")),
+				},
+			},
 		},
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+	})
+}
+
+func TestAccTimeRotating_AlignTo_basic(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
 		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
-				ConfigFile: config.TestNameFile("test.tf"),
+				Config: testAccConfigTimeRotatingRFC3339RotationMonthsAlignTo(baseTimestamp.Format(time.RFC3339), 1, "month", ""),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
 					},
 				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-03-01T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+				},
 			},
 			{
-				ConfigFile: config.TestNameFile("test.tf"),
+				// 2030-02-17 is a Sunday.
+				Config: testAccConfigTimeRotatingRFC3339RotationMonthsAlignTo(baseTimestamp.Format(time.RFC3339), 1, "week", ""),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectEmptyPlan(),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-18T00:00:00Z")),
 					},
 				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-18T00:00:00Z")),
+				},
 			},
 			{
-				PreConfig: func() {
-					time.Sleep(time.Duration(1) * time.Minute)
-				},
-				ConfigFile: config.TestNameFile("test.tf"),
+				Config: testAccConfigTimeRotatingRFC3339RotationMonthsAlignTo(baseTimestamp.Format(time.RFC3339), 1, "week", "SUNDAY"),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionNoop),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionNoop),
-						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionNoop),
-					},
-					PostApplyPostRefresh: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionNoop),
-						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionNoop),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionNoop),
-						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-24T00:00:00Z")),
 					},
 				},
-				ExpectNonEmptyPlan: true,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-24T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("week_start"), knownvalue.StringExact("SUNDAY")),
+				},
 			},
 		},
 	})
 }
 
-func TestAccTimeRotation_Upgrade(t *testing.T) {
+func TestAccTimeRotating_Timezone_basic(t *testing.T) {
+	t.Parallel()
 	resourceName := "time_rotating.test"
-	timestamp := time.Now().UTC()
-	expiredTimestamp := time.Now().UTC().AddDate(-2, 0, 0)
 
-	resource.Test(t, resource.TestCase{
-		CheckDestroy: nil,
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
-				ExternalProviders: providerVersion080(),
-				Config:            testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
+				Config: testAccConfigTimeRotatingRotationDaysTimezone(1, "America/New_York"),
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_years"), knownvalue.Int64Exact(3)),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.AddDate(3, 0, 0).Format(time.RFC3339))),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_months"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_hours"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_minutes"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T05:00:00-05:00")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T05:00:00-05:00")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_hour"), knownvalue.Int64Exact(5)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(1894960800)),
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
-				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
-				PlanOnly:                 true,
-			},
-			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
-				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
+				Config: testAccConfigTimeRotatingRotationDaysTimezone(1, "Asia/Tokyo"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T19:00:00+09:00")),
+					},
+				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_years"), knownvalue.Int64Exact(3)),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.AddDate(3, 0, 0).Format(time.RFC3339))),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_months"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_hours"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_minutes"), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T05:00:00-05:00")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_day"), knownvalue.Int64Exact(18)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_hour"), knownvalue.Int64Exact(19)),
 				},
 			},
+		},
+	})
+}
+
+func TestAccTimeRotating_Timezone_daylightSavingTime(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	// Local midnight in Europe/Berlin, the day before daylight saving time starts.
+	baseTimestamp := "2030-03-30T00:00:00+01:00"
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.March, 29, 23, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
-				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(expiredTimestamp.Format(time.RFC3339), 3),
-				PlanOnly:                 true,
-				ExpectNonEmptyPlan:       true,
+				Config: fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339       = %q
+  rotation_days = 2
+  timezone      = "Europe/Berlin"
+}
+`, baseTimestamp),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-04-01T00:00:00+02:00")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("year"), knownvalue.Int64Exact(2030)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("month"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("day"), knownvalue.Int64Exact(31)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(22)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_year"), knownvalue.Int64Exact(2030)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_month"), knownvalue.Int64Exact(4)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_day"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("local_hour"), knownvalue.Int64Exact(0)),
+				},
 			},
 		},
 	})
 }
 
-func TestAccTimeRotating_Validators(t *testing.T) {
+func TestAccTimeRotating_MultipleRotationUnits(t *testing.T) {
 	t.Parallel()
-	timestamp := time.Now().UTC()
+	resourceName := "time_rotating.test"
+
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
 
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
 		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(`resource "time_rotating" "test" {
-                     rfc3339 = %q
-                  }`, timestamp.Format(time.RFC3339)),
-				ExpectError: regexp.MustCompile(`.*Error: Missing Attribute Configuration`),
+				Config: fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339          = %q
+  rotation_months  = 1
+  rotation_days    = 1
+  rotation_hours   = 12
+  rotation_seconds = 30
+}
+`, baseTimestamp.Format(time.RFC3339)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-18T22:00:30Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_seconds"), knownvalue.Int64Exact(30)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("second"), knownvalue.Int64Exact(30)),
+				},
 			},
 			{
-				Config:      testAccConfigTimeRotatingRFC3339RotationMinutes(timestamp.Format(time.RFC822), 1),
-				ExpectError: regexp.MustCompile(`.*Invalid RFC3339 String Value`),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimeRotatingImportStateIdFunc(),
+				ImportStateVerify: true,
 			},
 			{
-				Config:      testAccConfigTimeRotatingRFC3339RotationMinutes(timestamp.Format(time.RFC3339), 0),
-				ExpectError: regexp.MustCompile(`.*must be at least 1`),
+				Config: fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339          = %q
+  rotation_days    = 1
+  rotation_hours   = 12
+  rotation_seconds = 30
+}
+`, baseTimestamp.Format(time.RFC3339)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T22:00:30Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T22:00:30Z")),
+				},
 			},
 		},
 	})
 }
 
-func testAccTimeRotatingImportStateIdFunc() resource.ImportStateIdFunc {
-	return func(s *terraform.State) (string, error) {
-		resourceName := "time_rotating.test"
-		rs, ok := s.RootModule().Resources[resourceName]
-		if !ok {
-			return "", fmt.Errorf("Not found: %s", resourceName)
-		}
-
-		rotationYears := rs.Primary.Attributes["rotation_years"]
-		rotationMonths := rs.Primary.Attributes["rotation_months"]
-		rotationDays := rs.Primary.Attributes["rotation_days"]
-		rotationHours := rs.Primary.Attributes["rotation_hours"]
-		rotationMinutes := rs.Primary.Attributes["rotation_minutes"]
+func TestAccTimeRotating_RotationDuration_basic(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
 
-		if rotationYears != "" || rotationMonths != "" || rotationDays != "" || rotationHours != "" || rotationMinutes != "" {
-			return fmt.Sprintf("%s,%s,%s,%s,%s,%s", rs.Primary.ID, rotationYears, rotationMonths, rotationDays, rotationHours, rotationMinutes), nil
-		}
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
 
-		return fmt.Sprintf("%s,%s", rs.Primary.ID, rs.Primary.Attributes["rotation_rfc3339"]), nil
-	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRFC3339RotationDuration(baseTimestamp.Format(time.RFC3339), "P1M2DT3H"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_duration"), knownvalue.StringExact("P1M2DT3H")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-19T13:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimeRotatingImportStateIdFunc(),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigTimeRotatingRFC3339RotationDuration(baseTimestamp.Format(time.RFC3339), "36h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T22:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_duration"), knownvalue.StringExact("36h")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T22:00:00Z")),
+				},
+			},
+		},
+	})
 }
 
-func testAccConfigTimeRotatingTriggers1(keeperKey1 string, keeperKey2 string) string {
-	return fmt.Sprintf(`
-resource "time_rotating" "test" {
-  triggers = {
-    %[1]q = %[2]q
-  }
-  rotation_days = 1
-}
-`, keeperKey1, keeperKey2)
-}
+func TestAccTimeRotating_RotationJitter_basic(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
 
-func testAccConfigTimeRotatingRFC3339RotationDays(rfc3339 string, rotationDays int) string {
-	return fmt.Sprintf(`
-resource "time_rotating" "test" {
-  rotation_days = %[2]d
-  rfc3339       = %[1]q
-}
-`, rfc3339, rotationDays)
-}
+	baseTimestamp := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	mockClock := timetesting.NewFakeClock(baseTimestamp)
 
-func testAccConfigTimeRotatingRotationDays(rotationDays int) string {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationJitter(baseTimestamp.Format(time.RFC3339), "6h", "workspace-a"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T15:14:15Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("applied_jitter"), knownvalue.StringExact("5h14m15s")),
+					// The jitter is added once to the next rotation as well.
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("next_rotation_rfc3339"), knownvalue.StringExact("2030-03-18T15:14:15Z")),
+				},
+			},
+			{
+				Config:   testAccConfigTimeRotatingRotationJitter(baseTimestamp.Format(time.RFC3339), "6h", "workspace-a"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationJitter(baseTimestamp.Format(time.RFC3339), "6h", "workspace-b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T13:42:23Z")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("applied_jitter"), knownvalue.StringExact("3h42m23s")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T13:42:23Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("applied_jitter"), knownvalue.StringExact("3h42m23s")),
+				},
+			},
+		},
+	})
+}
+
+func TestTimeRotatingRotationJitterNextRotation(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		plan                 timeRotatingModelV2
+		expectedRotation     string
+		expectedNextRotation string
+	}{
+		"rotation-days": {
+			plan: timeRotatingModelV2{
+				RotationDays:   types.Int64Value(30),
+				RotationJitter: types.StringValue("6h"),
+				JitterSeed:     types.StringValue("workspace-a"),
+			},
+			expectedRotation:     "2030-02-16T15:14:15Z",
+			expectedNextRotation: "2030-03-18T15:14:15Z",
+		},
+		"rotation-cron": {
+			plan: timeRotatingModelV2{
+				RotationCron:   types.StringValue("0 3 * * MON"),
+				RotationJitter: types.StringValue("6h"),
+				JitterSeed:     types.StringValue("workspace-b"),
+			},
+			expectedRotation:     "2030-01-21T06:42:23Z",
+			expectedNextRotation: "2030-01-28T06:42:23Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diags := setRotationValues(&testCase.plan, base); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			// Both rotations are offset by the same jitter from the schedule.
+			if got := testCase.plan.RotationRFC3339.ValueString(); got != testCase.expectedRotation {
+				t.Errorf("expected rotation_rfc3339 %s, got: %s", testCase.expectedRotation, got)
+			}
+
+			if got := testCase.plan.NextRotationRFC3339.ValueString(); got != testCase.expectedNextRotation {
+				t.Errorf("expected next_rotation_rfc3339 %s, got: %s", testCase.expectedNextRotation, got)
+			}
+		})
+	}
+}
+
+func TestAccTimeRotating_RotationJitter_createdTogether(t *testing.T) {
+	t.Parallel()
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			// Resources created in the same apply share the base timestamp, so
+			// only their seeds spread the rotations.
+			{
+				Config: `
+resource "time_rotating" "a" {
+  rotation_days   = 30
+  rotation_jitter = "6h"
+  jitter_seed     = "workspace-a"
+}
+
+resource "time_rotating" "b" {
+  rotation_days   = 30
+  rotation_jitter = "6h"
+  jitter_seed     = "workspace-b"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("time_rotating.a", tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue("time_rotating.b", tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue("time_rotating.a", tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T15:14:15Z")),
+					statecheck.ExpectKnownValue("time_rotating.b", tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T13:42:23Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_RotationJitter_requiresSeed(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigTimeRotatingRotationJitter("2030-01-17T10:00:00Z", "6h", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_HistorySize(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	previousRotation := func(base string, rotation string) knownvalue.Check {
+		return knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"base_rfc3339":     knownvalue.StringExact(base),
+			"rotation_rfc3339": knownvalue.StringExact(rotation),
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysHistorySize(1, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			// Rotations are updates since the resource is not removed from state during ReadResource().
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysHistorySize(1, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rfc3339")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("previous_rotations")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-19T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-20T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.ListExact([]knownvalue.Check{
+						previousRotation("2030-01-17T10:00:00Z", "2030-01-18T10:00:00Z"),
+					})),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysHistorySize(1, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-22T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.ListExact([]knownvalue.Check{
+						previousRotation("2030-01-19T10:00:00Z", "2030-01-20T10:00:00Z"),
+						previousRotation("2030-01-17T10:00:00Z", "2030-01-18T10:00:00Z"),
+					})),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysHistorySize(1, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-24T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.ListExact([]knownvalue.Check{
+						previousRotation("2030-01-21T10:00:00Z", "2030-01-22T10:00:00Z"),
+						previousRotation("2030-01-19T10:00:00Z", "2030-01-20T10:00:00Z"),
+					})),
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationDaysHistorySize(1, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-24T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.ListExact([]knownvalue.Check{
+						previousRotation("2030-01-21T10:00:00Z", "2030-01-22T10:00:00Z"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_LeadTime(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysLeadTime(10, "48h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-27T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("next_rotation_rfc3339"), knownvalue.StringExact("2030-02-06T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(false)),
+				},
+			},
+			// Refreshing the resource inside the lead window does not change the rotation.
+			{
+				PreConfig: func() {
+					mockClock.Increment(8*24*time.Hour + time.Hour)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysLeadTime(10, "48h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-27T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysLeadTime(10, "48h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-06T11:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("next_rotation_rfc3339"), knownvalue.StringExact("2030-02-16T11:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_TrackRotation(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysTrackRotation(1, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("seconds_until_rotation"), knownvalue.Int64Exact(24*60*60)),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(24 * time.Hour)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysTrackRotation(1, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("seconds_until_rotation"), knownvalue.Int64Exact(0)),
+				},
+			},
+			// Once the countdown has passed zero the resource is rotated and the
+			// countdown starts again from the new rotation timestamp.
+			{
+				PreConfig: func() {
+					mockClock.Increment(time.Second)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysTrackRotation(1, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-19T10:00:01Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("seconds_until_rotation"), knownvalue.Int64Exact(24*60*60)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_RotationModeInPlace(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysRotationMode(1, "in_place"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("generation"), knownvalue.Int64Exact(1)),
+				},
+			},
+			// Rotations are updates since the resource is not removed from state during ReadResource().
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysRotationMode(1, "in_place"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("generation"), knownvalue.Int64Exact(2)),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rfc3339")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("previous_rotations"), knownvalue.Null()),
+						// The new values are taken from the time of the apply.
+						timetesting.IncrementClock(mockClock, time.Minute),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-19T10:01:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-20T10:01:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("generation"), knownvalue.Int64Exact(2)),
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationDaysRotationMode(1, "in_place"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationDaysRotationMode(1, "replace"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-20T10:01:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("generation"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_MaintenanceWindow(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	// 2030-01-17 is a Thursday.
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysMaintenanceWindow(1, "SATURDAY", "22:00", "4h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+				},
+			},
+			// The rotation timestamp has passed outside the maintenance window, so the rotation is deferred.
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysMaintenanceWindow(1, "SATURDAY", "22:00", "4h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(12 * time.Hour)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysMaintenanceWindow(1, "SATURDAY", "22:00", "4h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-19T22:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-20T22:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_WarnBefore(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysWarnBefore(10, "48h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-27T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("warn_before"), knownvalue.StringExact("48h")),
+				},
+			},
+			// The warning inside the warn_before duration does not change the plan.
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 9)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysWarnBefore(10, "48h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestTimeRotatingWarnBeforeRotation(t *testing.T) {
+	t.Parallel()
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 25, 10, 0, 0, 0, time.UTC))
+	r := &timeRotatingResource{clock: mockClock}
+
+	testCases := map[string]struct {
+		state    timeRotatingModelV2
+		expected string
+	}{
+		"outside-warn-before": {
+			state: timeRotatingModelV2{
+				RotationRFC3339: timetypes.NewRFC3339ValueMust("2030-01-28T10:00:00Z"),
+				WarnBefore:      types.StringValue("48h"),
+			},
+		},
+		"replace": {
+			state: timeRotatingModelV2{
+				RotationRFC3339: timetypes.NewRFC3339ValueMust("2030-01-26T10:00:00Z"),
+				WarnBefore:      types.StringValue("48h"),
+			},
+			expected: "The rotation timestamp (2030-01-26T10:00:00Z) of this time_rotating resource is in 24h0m0s, " +
+				"within the warn_before duration (48h). The first apply after the rotation timestamp has passed will " +
+				"replace this resource and the resources that depend on it.",
+		},
+		"in-place": {
+			state: timeRotatingModelV2{
+				RotationRFC3339: timetypes.NewRFC3339ValueMust("2030-01-26T10:00:00Z"),
+				RotationMode:    types.StringValue(rotationModeInPlace),
+				WarnBefore:      types.StringValue("P2D"),
+			},
+			expected: "The rotation timestamp (2030-01-26T10:00:00Z) of this time_rotating resource is in 24h0m0s, " +
+				"within the warn_before duration (P2D). The first apply after the rotation timestamp has passed will " +
+				"update this resource in place and the resources that depend on it.",
+		},
+		"expired": {
+			state: timeRotatingModelV2{
+				RotationRFC3339: timetypes.NewRFC3339ValueMust("2030-01-24T10:00:00Z"),
+				WarnBefore:      types.StringValue("48h"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := r.warnBeforeRotation(&testCase.state, &testCase.state)
+
+			if testCase.expected == "" {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got: %v", diags)
+				}
+
+				return
+			}
+
+			if len(diags) != 1 || diags.WarningsCount() != 1 {
+				t.Fatalf("expected one warning, got: %v", diags)
+			}
+
+			if diags[0].Detail() != testCase.expected {
+				t.Errorf("expected detail %q, got: %q", testCase.expected, diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestAccTimeRotating_ClockSkewTolerance(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysClockSkewTolerance(1, "5m"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+				},
+			},
+			// The rotation timestamp has passed, but not by more than the skew tolerance.
+			{
+				PreConfig: func() {
+					mockClock.Increment(24*time.Hour + time.Minute)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysClockSkewTolerance(1, "5m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(5 * time.Minute)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysClockSkewTolerance(1, "5m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-18T10:06:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-19T10:06:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_AnchorRFC3339(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingAnchorRFC3339RotationMonths("2030-01-01T00:00:00Z", 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-04-01T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("next_rotation_rfc3339"), knownvalue.StringExact("2030-07-01T00:00:00Z")),
+				},
+			},
+			// The rotation after a late apply stays on the anchored schedule.
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 3, 0)
+				},
+				Config: testAccConfigTimeRotatingAnchorRFC3339RotationMonths("2030-01-01T00:00:00Z", 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-04-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-07-01T00:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_RotateBefore(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysRotateBefore(30, `["2030-02-01T00:00:00Z", "2030-03-01T00:00:00Z"]`, "72h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-29T00:00:00Z")),
+					// The lead window opens lead_time before the rotation, which is itself lead_time before the deadline.
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(8*24*time.Hour + 14*time.Hour)
+				},
+				Config: testAccConfigTimeRotatingRotationDaysRotateBefore(30, `["2030-02-01T00:00:00Z", "2030-03-01T00:00:00Z"]`, "72h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-29T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationDaysRotateBefore(30, `["2030-03-01T00:00:00Z"]`, "72h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T10:00:00Z")),
+				},
+			},
+			// Deadlines that are unknown during planning are applied during the update.
+			{
+				Config: `
+resource "time_offset" "test" {
+  offset_days = 5
+}
+
+resource "time_rotating" "test" {
+  rotation_days = 30
+  rotate_before = [time_offset.test.rfc3339]
+  lead_time     = "72h"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-28T00:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("in_lead_window"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_BusinessDays(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	// 2030-01-17 is a Thursday.
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "time_rotating" "test" {
+  rotation_business_days = 2
+  holidays               = ["2030-01-21"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-22T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("next_rotation_rfc3339"), knownvalue.StringExact("2030-01-24T10:00:00Z")),
+				},
+			},
+			// A rotation timestamp on a weekend day is moved to the next business day.
+			{
+				Config: `
+resource "time_rotating" "test" {
+  rotation_days = 2
+  weekend_days  = ["SATURDAY", "SUNDAY"]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-21T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-01-21T10:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_Identity(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity is only available in Terraform v1.12.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDaysEmptyTriggers(30),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						"rfc3339":           knownvalue.StringExact("2030-01-17T10:00:00Z"),
+						"rotation_cron":     knownvalue.Null(),
+						"rotation_days":     knownvalue.Int64Exact(30),
+						"rotation_duration": knownvalue.Null(),
+						"rotation_hours":    knownvalue.Null(),
+						"rotation_minutes":  knownvalue.Null(),
+						"rotation_months":   knownvalue.Null(),
+						"rotation_rfc3339":  knownvalue.Null(),
+						"rotation_seconds":  knownvalue.Null(),
+						"rotation_years":    knownvalue.Null(),
+					}),
+				},
+			},
+			// The identity follows rotation arguments updated in place.
+			{
+				Config: testAccConfigTimeRotatingRotationDaysEmptyTriggers(7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						"rfc3339":           knownvalue.StringExact("2030-01-17T10:00:00Z"),
+						"rotation_cron":     knownvalue.Null(),
+						"rotation_days":     knownvalue.Int64Exact(7),
+						"rotation_duration": knownvalue.Null(),
+						"rotation_hours":    knownvalue.Null(),
+						"rotation_minutes":  knownvalue.Null(),
+						"rotation_months":   knownvalue.Null(),
+						"rotation_rfc3339":  knownvalue.Null(),
+						"rotation_seconds":  knownvalue.Null(),
+						"rotation_years":    knownvalue.Null(),
+					}),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_ImportKeyValue(t *testing.T) {
+	resourceName := "time_rotating.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingTriggers1("key1", "value1"),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("base=%s,rotation_days=1,triggers.key1=value1", rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "base=2024-01-01T00:00:00Z,rotation_days=1,rotation_duration=24h",
+				ExpectError:   regexp.MustCompile(`Unexpected Format of ID`),
+			},
+			// Only rotation_cron and triggers values can contain commas.
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "base=2024-01-01T00:00:00Z,rotation_days=1,2",
+				ExpectError:   regexp.MustCompile(`the rotation_days value cannot contain commas`),
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_ImportKeyValueCron(t *testing.T) {
+	resourceName := "time_rotating.test"
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRFC3339RotationCron(timestamp, "0 3 * 1,4,7,10 *"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("base=%s,rotation_cron=0 3 * 1,4,7,10 *", timestamp),
+				ImportStateVerify: true,
+			},
+			// The positional syntax still imports the cron expression.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s,0 3 * 1,4,7,10 *", timestamp),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_Components(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRotationDays(30),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_components"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"day":    knownvalue.Int64Exact(17),
+						"hour":   knownvalue.Int64Exact(10),
+						"minute": knownvalue.Int64Exact(0),
+						"month":  knownvalue.Int64Exact(1),
+						"second": knownvalue.Int64Exact(0),
+						"unix":   knownvalue.Int64Exact(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC).Unix()),
+						"year":   knownvalue.Int64Exact(2030),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_components"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"day":    knownvalue.Int64Exact(16),
+						"hour":   knownvalue.Int64Exact(10),
+						"minute": knownvalue.Int64Exact(0),
+						"month":  knownvalue.Int64Exact(2),
+						"second": knownvalue.Int64Exact(0),
+						"unix":   knownvalue.Int64Exact(time.Date(2030, time.February, 16, 10, 0, 0, 0, time.UTC).Unix()),
+						"year":   knownvalue.Int64Exact(2030),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("day"), knownvalue.Int64Exact(16)),
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingRotationDays(60),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_components").AtMapKey("day"), knownvalue.Int64Exact(17)),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_components").AtMapKey("month"), knownvalue.Int64Exact(3)),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_components").AtMapKey("day"), knownvalue.Int64Exact(18)),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_MoveStateFromTimeStatic(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Moving state between resource types is only available in Terraform v1.8.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticTriggers1("key1", "value1"),
+			},
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 1)
+				},
+				Config: testAccConfigTimeRotatingMovedFromTimeStatic("key1", "value1", 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("triggers").AtMapKey("key1"), knownvalue.StringExact("value1")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("2030-02-16T10:00:00Z")),
+				},
+			},
+			{
+				Config: testAccConfigTimeRotatingMovedFromTimeStatic("key1", "value1", 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// When the resource is being updated, the "rotation_rfc3339" value
+// is computed during ModifyPlan(). If any of the "rotation_" attributes
+// are unknown during the plan, then an incorrect value will be calculated
+// for "rotation_rfc3339" during the initial plan which will differ from
+// the final plan, causing Terraform core to throw an error
+// Ref: https://github.com/hashicorp/terraform-provider-time/issues/227
+func TestAccTimeRotating_UpdateUnknownValue(t *testing.T) {
+	t.Parallel()
+	resourceName := "time_rotating.test"
+
+	baseTimestamp := time.Now().UTC()
+	rotationDays := 7
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeRotatingRFC3339RotationDays(baseTimestamp.Format(time.RFC3339), rotationDays),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_rfc3339")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Int64Exact(int64(rotationDays))),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(baseTimestamp.AddDate(0, 0, rotationDays).Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Int64Exact(int64(rotationDays))),
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_static" "unknown" {}
+
+					resource "time_rotating" "test" {
+  							rotation_days   = time_static.unknown.day
+  							rfc3339         = %q
+						}`, baseTimestamp.Format(time.RFC3339)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("rotation_days")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact("0001-01-01T00:00:00Z")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(baseTimestamp.Format(time.RFC3339))),
+					},
+				},
+				ExpectError: regexp.MustCompile(`.*Error: Provider produced inconsistent final plan`),
+			},
+		},
+	})
+}
+
+// The "time_rotating" resource predates the `replace_triggered_by`
+// lifestyle argument introduced in Terraform v1.2.0. The `replace_triggered_by` argument looks
+// for an update or replacement of the supplied resource instance. Because the "time_rotating" rotation
+// checking logic is run during ReadResource() and the resource is removed from state,
+// a rotation is considered to be a creation of a new resource rather than an update or replacement.
+// Ref: https://github.com/hashicorp/terraform-provider-time/issues/118
+func TestAccTimeRotating_LifecycleReplaceTriggeredBy(t *testing.T) {
+	t.Parallel()
+
+	mockClock := timetesting.NewFakeClock(time.Now().UTC())
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// "terraform_data" resource is only available in Terraform v1.4.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_4_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile: config.TestNameFile("test.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ConfigFile: config.TestNameFile("test.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.Increment(time.Minute + time.Second)
+				},
+				ConfigFile: config.TestNameFile("test.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("time_rotating.configured_rfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.configured_rotationrfc3339", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("time_rotating.computed_rotation", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("terraform_data.test_computed_rotation", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rfc3339", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("terraform_data.test_configured_rotationrfc3339", plancheck.ResourceActionNoop),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTimeRotation_Upgrade(t *testing.T) {
+	resourceName := "time_rotating.test"
+	timestamp := time.Now().UTC()
+	expiredTimestamp := time.Now().UTC().AddDate(-2, 0, 0)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion080(),
+				Config:            testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_years"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.AddDate(3, 0, 0).Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_months"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_hours"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_minutes"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.NotNull()),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(timestamp.Format(time.RFC3339), 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_years"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.AddDate(3, 0, 0).Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_months"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_days"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_hours"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_minutes"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("base_components").AtMapKey("unix"), knownvalue.Int64Exact(timestamp.Unix())),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_components").AtMapKey("unix"), knownvalue.Int64Exact(timestamp.AddDate(3, 0, 0).Unix())),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   testAccConfigTimeRotatingRFC3339RotationYears(expiredTimestamp.Format(time.RFC3339), 3),
+				PlanOnly:                 true,
+				ExpectNonEmptyPlan:       true,
+			},
+		},
+	})
+}
+
+// Version 0 of the schema used only the last configured rotation unit, so
+// existing rotation timestamps must be kept when upgrading the state.
+func TestAccTimeRotation_UpgradeMultipleRotationUnits(t *testing.T) {
+	resourceName := "time_rotating.test"
+	timestamp := time.Now().UTC()
+	config := fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339        = %q
+  rotation_days  = 1
+  rotation_hours = 12
+}
+`, timestamp.Format(time.RFC3339))
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion080(),
+				Config:            config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.Add(12*time.Hour).Format(time.RFC3339))),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_rfc3339"), knownvalue.StringExact(timestamp.Add(12*time.Hour).Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_seconds"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeRotating_Validators(t *testing.T) {
+	t.Parallel()
+	timestamp := time.Now().UTC()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rfc3339 = %q
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Missing Attribute Configuration`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationMinutes(timestamp.Format(time.RFC822), 1),
+				ExpectError: regexp.MustCompile(`.*Invalid RFC3339 String Value`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationMinutes(timestamp.Format(time.RFC3339), 0),
+				ExpectError: regexp.MustCompile(`.*must be at least 1`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationCron(timestamp.Format(time.RFC3339), "0 3 * *"),
+				ExpectError: regexp.MustCompile(`.*Invalid Cron Expression`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rotation_cron    = "0 3 * * *"
+                     rotation_rfc3339 = %q
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rfc3339       = %q
+                     rotation_days = 1
+                     week_start    = "SUNDAY"
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationMonthsAlignTo(timestamp.Format(time.RFC3339), 1, "week", "someday"),
+				ExpectError: regexp.MustCompile(`.*Invalid Weekday`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysTimezone(1, "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile(`.*Invalid Time Zone`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationDuration(timestamp.Format(time.RFC3339), "P1H"),
+				ExpectError: regexp.MustCompile(`.*Invalid Duration`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRFC3339RotationDuration(timestamp.Format(time.RFC3339), "-36h"),
+				ExpectError: regexp.MustCompile(`.*Invalid Duration`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rfc3339       = %q
+                     rotation_days = 1
+                     jitter_seed   = "workspace-a"
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysRotationMode(1, "rolling"),
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rfc3339       = %q
+                     rotation_days = 1
+                     rotation_mode = "in_place"
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "time_rotating" "test" {
+                     rfc3339       = %q
+                     rotation_days = 1
+                     history_size  = 1
+                  }`, timestamp.Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysMaintenanceWindow(1, "SATURDAY", "25:00", "4h"),
+				ExpectError: regexp.MustCompile(`.*must be a time of day in HH:MM format`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysMaintenanceWindow(1, "CAKEDAY", "22:00", "4h"),
+				ExpectError: regexp.MustCompile(`.*Invalid Weekday`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysWarnBefore(1, "0s"),
+				ExpectError: regexp.MustCompile(`.*Invalid Duration`),
+			},
+			{
+				Config:      testAccConfigTimeRotatingRotationDaysClockSkewTolerance(1, "-5m"),
+				ExpectError: regexp.MustCompile(`.*Invalid Duration`),
+			},
+			{
+				Config: `resource "time_rotating" "test" {
+                     anchor_rfc3339 = "2030-01-01T00:00:00Z"
+                     rotation_cron  = "0 3 * * *"
+                  }`,
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "time_rotating" "test" {
+                     rotate_before    = ["2030-01-01T00:00:00Z"]
+                     rotation_rfc3339 = "2030-01-01T00:00:00Z"
+                  }`,
+				ExpectError: regexp.MustCompile(`.*Error: Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "time_rotating" "test" {
+                     rotation_business_days = 1
+                     holidays               = ["2030-12-32"]
+                  }`,
+				ExpectError: regexp.MustCompile(`.*Invalid Date`),
+			},
+		},
+	})
+}
+
+func testAccTimeRotatingImportStateIdFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		resourceName := "time_rotating.test"
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		rotationYears := rs.Primary.Attributes["rotation_years"]
+		rotationMonths := rs.Primary.Attributes["rotation_months"]
+		rotationDays := rs.Primary.Attributes["rotation_days"]
+		rotationHours := rs.Primary.Attributes["rotation_hours"]
+		rotationMinutes := rs.Primary.Attributes["rotation_minutes"]
+		rotationSeconds := rs.Primary.Attributes["rotation_seconds"]
+		rotationDuration := rs.Primary.Attributes["rotation_duration"]
+		rotationCron := rs.Primary.Attributes["rotation_cron"]
+
+		if rotationCron != "" {
+			return fmt.Sprintf("%s,%s", rs.Primary.ID, rotationCron), nil
+		}
+
+		if rotationDuration != "" {
+			return fmt.Sprintf("%s,%s", rs.Primary.ID, rotationDuration), nil
+		}
+
+		if rotationSeconds != "" {
+			return fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s", rs.Primary.ID, rotationYears, rotationMonths, rotationDays, rotationHours, rotationMinutes, rotationSeconds), nil
+		}
+
+		if rotationYears != "" || rotationMonths != "" || rotationDays != "" || rotationHours != "" || rotationMinutes != "" {
+			return fmt.Sprintf("%s,%s,%s,%s,%s,%s", rs.Primary.ID, rotationYears, rotationMonths, rotationDays, rotationHours, rotationMinutes), nil
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.ID, rs.Primary.Attributes["rotation_rfc3339"]), nil
+	}
+}
+
+func testAccConfigTimeRotatingTriggers1(keeperKey1 string, keeperKey2 string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  triggers = {
+    %[1]q = %[2]q
+  }
+  rotation_days = 1
+}
+`, keeperKey1, keeperKey2)
+}
+
+func testAccConfigTimeRotatingRFC3339RotationDays(rfc3339 string, rotationDays int) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[2]d
+  rfc3339       = %[1]q
+}
+`, rfc3339, rotationDays)
+}
+
+func testAccConfigTimeRotatingRotationDays(rotationDays int) string {
 	return fmt.Sprintf(`
 resource "time_rotating" "test" {
   rotation_days = %d
@@ -1518,3 +2989,185 @@ resource "time_rotating" "test" {
 }
 `, rotationRfc3339)
 }
+
+func testAccConfigTimeRotatingRFC3339RotationCron(rfc3339 string, rotationCron string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_cron = %[2]q
+  rfc3339       = %[1]q
+}
+`, rfc3339, rotationCron)
+}
+
+func testAccConfigTimeRotatingRFC3339RotationMonthsAlignTo(rfc3339 string, rotationMonths int, alignTo string, weekStart string) string {
+	if weekStart != "" {
+		return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  align_to        = %[3]q
+  rfc3339         = %[1]q
+  rotation_months = %[2]d
+  week_start      = %[4]q
+}
+`, rfc3339, rotationMonths, alignTo, weekStart)
+	}
+
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  align_to        = %[3]q
+  rfc3339         = %[1]q
+  rotation_months = %[2]d
+}
+`, rfc3339, rotationMonths, alignTo)
+}
+
+func testAccConfigTimeRotatingRotationDaysTimezone(rotationDays int, timezone string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  timezone      = %[2]q
+}
+`, rotationDays, timezone)
+}
+
+func testAccConfigTimeRotatingRFC3339RotationDuration(rfc3339 string, rotationDuration string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339           = %[1]q
+  rotation_duration = %[2]q
+}
+`, rfc3339, rotationDuration)
+}
+
+func testAccConfigTimeRotatingRotationJitter(rfc3339 string, rotationJitter string, jitterSeed string) string {
+	if jitterSeed != "" {
+		return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339         = %[1]q
+  rotation_days   = 30
+  rotation_jitter = %[2]q
+  jitter_seed     = %[3]q
+}
+`, rfc3339, rotationJitter, jitterSeed)
+	}
+
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rfc3339         = %[1]q
+  rotation_days   = 30
+  rotation_jitter = %[2]q
+}
+`, rfc3339, rotationJitter)
+}
+
+func testAccConfigTimeRotatingRotationDaysHistorySize(rotationDays int, historySize int) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  history_size  = %[2]d
+}
+`, rotationDays, historySize)
+}
+
+func testAccConfigTimeRotatingRotationDaysLeadTime(rotationDays int, leadTime string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  lead_time     = %[2]q
+}
+`, rotationDays, leadTime)
+}
+
+func testAccConfigTimeRotatingRotationDaysTrackRotation(rotationDays int, trackRotation bool) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days  = %[1]d
+  track_rotation = %[2]t
+}
+`, rotationDays, trackRotation)
+}
+
+func testAccConfigTimeRotatingRotationDaysRotationMode(rotationDays int, rotationMode string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  rotation_mode = %[2]q
+}
+`, rotationDays, rotationMode)
+}
+
+func testAccConfigTimeRotatingRotationDaysMaintenanceWindow(rotationDays int, dayOfWeek string, startTime string, duration string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+
+  maintenance_window {
+    days_of_week = [%[2]q]
+    start_time   = %[3]q
+    duration     = %[4]q
+  }
+}
+`, rotationDays, dayOfWeek, startTime, duration)
+}
+
+func testAccConfigTimeRotatingRotationDaysWarnBefore(rotationDays int, warnBefore string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  warn_before   = %[2]q
+}
+`, rotationDays, warnBefore)
+}
+
+func testAccConfigTimeRotatingRotationDaysClockSkewTolerance(rotationDays int, clockSkewTolerance string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days        = %[1]d
+  clock_skew_tolerance = %[2]q
+}
+`, rotationDays, clockSkewTolerance)
+}
+
+func testAccConfigTimeRotatingAnchorRFC3339RotationMonths(anchorRFC3339 string, rotationMonths int) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  anchor_rfc3339  = %[1]q
+  rotation_months = %[2]d
+}
+`, anchorRFC3339, rotationMonths)
+}
+
+func testAccConfigTimeRotatingRotationDaysRotateBefore(rotationDays int, rotateBefore string, leadTime string) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  rotate_before = %[2]s
+  lead_time     = %[3]q
+}
+`, rotationDays, rotateBefore, leadTime)
+}
+
+func testAccConfigTimeRotatingMovedFromTimeStatic(keeperKey1 string, keeperKey2 string, rotationDays int) string {
+	return fmt.Sprintf(`
+moved {
+  from = time_static.test
+  to   = time_rotating.test
+}
+
+resource "time_rotating" "test" {
+  rotation_days = %[3]d
+
+  triggers = {
+    %[1]q = %[2]q
+  }
+}
+`, keeperKey1, keeperKey2, rotationDays)
+}
+
+func testAccConfigTimeRotatingRotationDaysEmptyTriggers(rotationDays int) string {
+	return fmt.Sprintf(`
+resource "time_rotating" "test" {
+  rotation_days = %[1]d
+  triggers      = {}
+}
+`, rotationDays)
+}
@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t *timeRotatingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := timeRotatingSchemaV0()
+	schemaV1 := timeRotatingSchemaV1()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeTimeRotatingStateV0toV2,
+		},
+		1: {
+			PriorSchema:   &schemaV1,
+			StateUpgrader: upgradeTimeRotatingStateV1toV2,
+		},
+	}
+}
+
+// upgradeTimeRotatingStateV0toV2 adds the attributes introduced since
+// version 0, such as rotation_cron, timezone and rotation_seconds, as null.
+// Without a time zone the local_ components match the UTC components of the
+// rotation timestamp. Version 0 calculated the rotation timestamp from the
+// last configured rotation unit only, while later versions add all units
+// together. The saved rotation timestamp is kept as-is so existing resources
+// are not replaced by the upgrade; the new calculation applies the next time
+// the rotation arguments change or the resource rotates.
+func upgradeTimeRotatingStateV0toV2(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var stateV0 timeRotatingModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseComponents, rotationComponents, diags := upgradeTimeRotatingComponents(stateV0.RFC3339, stateV0.RotationRFC3339)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateV2 := timeRotatingModelV2{
+		AlignTo:              types.StringNull(),
+		AnchorRFC3339:        timetypes.NewRFC3339Null(),
+		AppliedJitter:        types.StringNull(),
+		BaseComponents:       baseComponents,
+		ClockSkewTolerance:   types.StringNull(),
+		Day:                  stateV0.Day,
+		RotateBefore:         types.ListNull(timetypes.RFC3339Type{}),
+		RotationBusinessDays: types.Int64Null(),
+		RotationComponents:   rotationComponents,
+		RotationCron:         types.StringNull(),
+		RotationDays:         stateV0.RotationDays,
+		RotationDuration:     types.StringNull(),
+		RotationHours:        stateV0.RotationHours,
+		RotationJitter:       types.StringNull(),
+		RotationMinutes:      stateV0.RotationMinutes,
+		RotationMode:         types.StringNull(),
+		RotationMonths:       stateV0.RotationMonths,
+		RotationRFC3339:      stateV0.RotationRFC3339,
+		RotationSeconds:      types.Int64Null(),
+		RotationYears:        stateV0.RotationYears,
+		Hour:                 stateV0.Hour,
+		Generation:           types.Int64Null(),
+		Holidays:             types.ListNull(types.StringType),
+		HistorySize:          types.Int64Null(),
+		LocalDay:             stateV0.Day,
+		LocalHour:            stateV0.Hour,
+		LocalMinute:          stateV0.Minute,
+		LocalMonth:           stateV0.Month,
+		LocalSecond:          stateV0.Second,
+		LocalYear:            stateV0.Year,
+		JitterSeed:           types.StringNull(),
+		InLeadWindow:         types.BoolNull(),
+		LeadTime:             types.StringNull(),
+		MaintenanceWindow:    types.ObjectNull(timeRotatingMaintenanceWindowAttrTypes),
+		NextRotationRFC3339:  timetypes.NewRFC3339Null(),
+		Triggers:             stateV0.Triggers,
+		Minute:               stateV0.Minute,
+		Month:                stateV0.Month,
+		PreviousRotations:    types.ListNull(types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes}),
+		RFC3339:              stateV0.RFC3339,
+		Second:               stateV0.Second,
+		SecondsUntilRotation: types.Int64Null(),
+		Timezone:             types.StringNull(),
+		TrackRotation:        types.BoolNull(),
+		Unix:                 stateV0.Unix,
+		WarnBefore:           types.StringNull(),
+		WeekendDays:          types.ListNull(types.StringType),
+		WeekStart:            types.StringNull(),
+		Year:                 stateV0.Year,
+		ID:                   stateV0.ID,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateV2)...)
+}
+
+// upgradeTimeRotatingStateV1toV2 adds the base_components and
+// rotation_components attributes, which separate the components of the base
+// and rotation timestamps.
+func upgradeTimeRotatingStateV1toV2(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var stateV1 timeRotatingModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV1)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseComponents, rotationComponents, diags := upgradeTimeRotatingComponents(stateV1.RFC3339, stateV1.RotationRFC3339)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateV2 := timeRotatingModelV2{
+		AlignTo:              stateV1.AlignTo,
+		AnchorRFC3339:        stateV1.AnchorRFC3339,
+		AppliedJitter:        stateV1.AppliedJitter,
+		BaseComponents:       baseComponents,
+		ClockSkewTolerance:   stateV1.ClockSkewTolerance,
+		Day:                  stateV1.Day,
+		RotateBefore:         stateV1.RotateBefore,
+		RotationBusinessDays: stateV1.RotationBusinessDays,
+		RotationComponents:   rotationComponents,
+		RotationCron:         stateV1.RotationCron,
+		RotationDays:         stateV1.RotationDays,
+		RotationDuration:     stateV1.RotationDuration,
+		RotationHours:        stateV1.RotationHours,
+		RotationJitter:       stateV1.RotationJitter,
+		RotationMinutes:      stateV1.RotationMinutes,
+		RotationMode:         stateV1.RotationMode,
+		RotationMonths:       stateV1.RotationMonths,
+		RotationRFC3339:      stateV1.RotationRFC3339,
+		RotationSeconds:      stateV1.RotationSeconds,
+		RotationYears:        stateV1.RotationYears,
+		Generation:           stateV1.Generation,
+		Holidays:             stateV1.Holidays,
+		Hour:                 stateV1.Hour,
+		HistorySize:          stateV1.HistorySize,
+		LocalDay:             stateV1.LocalDay,
+		LocalHour:            stateV1.LocalHour,
+		LocalMinute:          stateV1.LocalMinute,
+		LocalMonth:           stateV1.LocalMonth,
+		LocalSecond:          stateV1.LocalSecond,
+		LocalYear:            stateV1.LocalYear,
+		InLeadWindow:         stateV1.InLeadWindow,
+		LeadTime:             stateV1.LeadTime,
+		MaintenanceWindow:    stateV1.MaintenanceWindow,
+		NextRotationRFC3339:  stateV1.NextRotationRFC3339,
+		JitterSeed:           stateV1.JitterSeed,
+		Triggers:             stateV1.Triggers,
+		Minute:               stateV1.Minute,
+		Month:                stateV1.Month,
+		PreviousRotations:    stateV1.PreviousRotations,
+		RFC3339:              stateV1.RFC3339,
+		Second:               stateV1.Second,
+		SecondsUntilRotation: types.Int64Null(),
+		Timezone:             stateV1.Timezone,
+		TrackRotation:        types.BoolNull(),
+		Unix:                 stateV1.Unix,
+		WarnBefore:           stateV1.WarnBefore,
+		WeekendDays:          stateV1.WeekendDays,
+		WeekStart:            stateV1.WeekStart,
+		Year:                 stateV1.Year,
+		ID:                   stateV1.ID,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateV2)...)
+}
+
+// upgradeTimeRotatingComponents returns the base_components and
+// rotation_components of a prior state. The rotation timestamp is null for
+// resources that were moved from another time resource and not yet updated.
+func upgradeTimeRotatingComponents(rfc3339 timetypes.RFC3339, rotationRFC3339 timetypes.RFC3339) (types.Object, types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baseComponents := types.ObjectNull(timeRotatingComponentsAttrTypes)
+	rotationComponents := types.ObjectNull(timeRotatingComponentsAttrTypes)
+
+	if !rfc3339.IsNull() {
+		timestamp, timestampDiags := rfc3339.ValueRFC3339Time()
+
+		diags.Append(timestampDiags...)
+		baseComponents = timeRotatingComponents(timestamp)
+	}
+
+	if !rotationRFC3339.IsNull() {
+		rotationTimestamp, rotationDiags := rotationRFC3339.ValueRFC3339Time()
+
+		diags.Append(rotationDiags...)
+		rotationComponents = timeRotatingComponents(rotationTimestamp)
+	}
+
+	return baseComponents, rotationComponents, diags
+}
+
+// timeRotatingSchemaV0 is the schema of version 0, as released up to
+// provider 0.14, without descriptions, validators and plan modifiers.
+func timeRotatingSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"day":              schema.Int64Attribute{Computed: true},
+			"rotation_days":    schema.Int64Attribute{Optional: true},
+			"rotation_hours":   schema.Int64Attribute{Optional: true},
+			"rotation_minutes": schema.Int64Attribute{Optional: true},
+			"rotation_months":  schema.Int64Attribute{Optional: true},
+			"rotation_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"rotation_years": schema.Int64Attribute{Optional: true},
+			"hour":           schema.Int64Attribute{Computed: true},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"minute": schema.Int64Attribute{Computed: true},
+			"month":  schema.Int64Attribute{Computed: true},
+			"rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"second": schema.Int64Attribute{Computed: true},
+			"unix":   schema.Int64Attribute{Computed: true},
+			"year":   schema.Int64Attribute{Computed: true},
+			"id": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+type timeRotatingModelV0 struct {
+	Day             types.Int64       `tfsdk:"day"`
+	RotationDays    types.Int64       `tfsdk:"rotation_days"`
+	RotationHours   types.Int64       `tfsdk:"rotation_hours"`
+	RotationMinutes types.Int64       `tfsdk:"rotation_minutes"`
+	RotationMonths  types.Int64       `tfsdk:"rotation_months"`
+	RotationRFC3339 timetypes.RFC3339 `tfsdk:"rotation_rfc3339"`
+	RotationYears   types.Int64       `tfsdk:"rotation_years"`
+	Hour            types.Int64       `tfsdk:"hour"`
+	Triggers        types.Map         `tfsdk:"triggers"`
+	Minute          types.Int64       `tfsdk:"minute"`
+	Month           types.Int64       `tfsdk:"month"`
+	RFC3339         timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Second          types.Int64       `tfsdk:"second"`
+	Unix            types.Int64       `tfsdk:"unix"`
+	Year            types.Int64       `tfsdk:"year"`
+	ID              timetypes.RFC3339 `tfsdk:"id"`
+}
+
+// timeRotatingSchemaV1 is the schema of version 1 without descriptions,
+// validators and plan modifiers, which are not needed to read the prior state.
+// Version 1 was introduced with rotation_seconds and kept gaining arguments,
+// from rotation_duration up to the business day arguments, without a version
+// bump. The prior schema is therefore the union of all of them. Attributes
+// missing from a state written before they were added are decoded as null,
+// and upgraded the same as unconfigured arguments. Arguments added to version
+// 2 later on, such as track_rotation, are likewise null in older version 2
+// states.
+func timeRotatingSchemaV1() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"align_to": schema.StringAttribute{Optional: true},
+			"anchor_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			"applied_jitter":       schema.StringAttribute{Computed: true},
+			"clock_skew_tolerance": schema.StringAttribute{Optional: true},
+			"day":                  schema.Int64Attribute{Computed: true},
+			"rotate_before": schema.ListAttribute{
+				ElementType: timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"rotation_business_days": schema.Int64Attribute{Optional: true},
+			"rotation_cron":          schema.StringAttribute{Optional: true},
+			"rotation_days":          schema.Int64Attribute{Optional: true},
+			"rotation_duration":      schema.StringAttribute{Optional: true},
+			"rotation_hours":         schema.Int64Attribute{Optional: true},
+			"rotation_jitter":        schema.StringAttribute{Optional: true},
+			"rotation_minutes":       schema.Int64Attribute{Optional: true},
+			"rotation_mode":          schema.StringAttribute{Optional: true},
+			"rotation_months":        schema.Int64Attribute{Optional: true},
+			"rotation_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"rotation_seconds": schema.Int64Attribute{Optional: true},
+			"rotation_years":   schema.Int64Attribute{Optional: true},
+			"holidays": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"hour":         schema.Int64Attribute{Computed: true},
+			"local_day":    schema.Int64Attribute{Computed: true},
+			"local_hour":   schema.Int64Attribute{Computed: true},
+			"local_minute": schema.Int64Attribute{Computed: true},
+			"local_month":  schema.Int64Attribute{Computed: true},
+			"local_second": schema.Int64Attribute{Computed: true},
+			"local_year":   schema.Int64Attribute{Computed: true},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"minute":         schema.Int64Attribute{Computed: true},
+			"month":          schema.Int64Attribute{Computed: true},
+			"generation":     schema.Int64Attribute{Computed: true},
+			"history_size":   schema.Int64Attribute{Optional: true},
+			"in_lead_window": schema.BoolAttribute{Computed: true},
+			"jitter_seed":    schema.StringAttribute{Optional: true},
+			"lead_time":      schema.StringAttribute{Optional: true},
+			"next_rotation_rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"previous_rotations": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: timeRotatingPreviousRotationAttrTypes},
+				Computed:    true,
+			},
+			"rfc3339": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"second":      schema.Int64Attribute{Computed: true},
+			"timezone":    schema.StringAttribute{Optional: true},
+			"unix":        schema.Int64Attribute{Computed: true},
+			"warn_before": schema.StringAttribute{Optional: true},
+			"week_start":  schema.StringAttribute{Optional: true},
+			"year":        schema.Int64Attribute{Computed: true},
+			"weekend_days": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_window": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"days_of_week": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					"duration":   schema.StringAttribute{Required: true},
+					"start_time": schema.StringAttribute{Required: true},
+					"timezone":   schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+}
+
+type timeRotatingModelV1 struct {
+	AlignTo              types.String      `tfsdk:"align_to"`
+	AnchorRFC3339        timetypes.RFC3339 `tfsdk:"anchor_rfc3339"`
+	AppliedJitter        types.String      `tfsdk:"applied_jitter"`
+	ClockSkewTolerance   types.String      `tfsdk:"clock_skew_tolerance"`
+	Day                  types.Int64       `tfsdk:"day"`
+	RotateBefore         types.List        `tfsdk:"rotate_before"`
+	RotationBusinessDays types.Int64       `tfsdk:"rotation_business_days"`
+	RotationCron         types.String      `tfsdk:"rotation_cron"`
+	RotationDays         types.Int64       `tfsdk:"rotation_days"`
+	RotationDuration     types.String      `tfsdk:"rotation_duration"`
+	RotationHours        types.Int64       `tfsdk:"rotation_hours"`
+	RotationJitter       types.String      `tfsdk:"rotation_jitter"`
+	RotationMinutes      types.Int64       `tfsdk:"rotation_minutes"`
+	RotationMode         types.String      `tfsdk:"rotation_mode"`
+	RotationMonths       types.Int64       `tfsdk:"rotation_months"`
+	RotationRFC3339      timetypes.RFC3339 `tfsdk:"rotation_rfc3339"`
+	RotationSeconds      types.Int64       `tfsdk:"rotation_seconds"`
+	RotationYears        types.Int64       `tfsdk:"rotation_years"`
+	Generation           types.Int64       `tfsdk:"generation"`
+	Holidays             types.List        `tfsdk:"holidays"`
+	Hour                 types.Int64       `tfsdk:"hour"`
+	HistorySize          types.Int64       `tfsdk:"history_size"`
+	LocalDay             types.Int64       `tfsdk:"local_day"`
+	LocalHour            types.Int64       `tfsdk:"local_hour"`
+	LocalMinute          types.Int64       `tfsdk:"local_minute"`
+	LocalMonth           types.Int64       `tfsdk:"local_month"`
+	LocalSecond          types.Int64       `tfsdk:"local_second"`
+	LocalYear            types.Int64       `tfsdk:"local_year"`
+	InLeadWindow         types.Bool        `tfsdk:"in_lead_window"`
+	LeadTime             types.String      `tfsdk:"lead_time"`
+	MaintenanceWindow    types.Object      `tfsdk:"maintenance_window"`
+	NextRotationRFC3339  timetypes.RFC3339 `tfsdk:"next_rotation_rfc3339"`
+	JitterSeed           types.String      `tfsdk:"jitter_seed"`
+	Triggers             types.Map         `tfsdk:"triggers"`
+	Minute               types.Int64       `tfsdk:"minute"`
+	Month                types.Int64       `tfsdk:"month"`
+	PreviousRotations    types.List        `tfsdk:"previous_rotations"`
+	RFC3339              timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Second               types.Int64       `tfsdk:"second"`
+	Timezone             types.String      `tfsdk:"timezone"`
+	Unix                 types.Int64       `tfsdk:"unix"`
+	WarnBefore           types.String      `tfsdk:"warn_before"`
+	WeekendDays          types.List        `tfsdk:"weekend_days"`
+	WeekStart            types.String      `tfsdk:"week_start"`
+	Year                 types.Int64       `tfsdk:"year"`
+	ID                   timetypes.RFC3339 `tfsdk:"id"`
+}
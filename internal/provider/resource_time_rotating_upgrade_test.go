@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeRotatingUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC)
+	rotation := base.AddDate(0, 0, 30)
+
+	// Attributes of version 0, as released up to provider 0.14.
+	stateV0 := fmt.Sprintf(`
+"day": 16,
+"hour": 10,
+"id": "2030-01-17T10:00:00Z",
+"minute": 0,
+"month": 2,
+"rfc3339": "2030-01-17T10:00:00Z",
+"rotation_days": 30,
+"rotation_hours": null,
+"rotation_minutes": null,
+"rotation_months": null,
+"rotation_rfc3339": "2030-02-16T10:00:00Z",
+"rotation_years": null,
+"second": 0,
+"triggers": {"key1": "value1"},
+"unix": %d,
+"year": 2030`, rotation.Unix())
+
+	// Attributes added to version 1 when it was introduced with
+	// rotation_seconds.
+	stateV1 := stateV0 + `,
+"align_to": null,
+"local_day": 16,
+"local_hour": 10,
+"local_minute": 0,
+"local_month": 2,
+"local_second": 0,
+"local_year": 2030,
+"rotation_cron": null,
+"rotation_seconds": null,
+"timezone": null,
+"week_start": null`
+
+	// Attributes added to version 1 afterwards, up to the rotate_before and
+	// business day arguments.
+	stateV1Union := stateV1 + `,
+"anchor_rfc3339": null,
+"applied_jitter": null,
+"clock_skew_tolerance": null,
+"generation": 3,
+"history_size": 1,
+"holidays": null,
+"in_lead_window": null,
+"jitter_seed": null,
+"lead_time": null,
+"maintenance_window": null,
+"next_rotation_rfc3339": "2030-03-18T10:00:00Z",
+"previous_rotations": [{"base_rfc3339": "2029-12-18T10:00:00Z", "rotation_rfc3339": "2030-01-17T10:00:00Z"}],
+"rotate_before": null,
+"rotation_business_days": null,
+"rotation_duration": null,
+"rotation_jitter": null,
+"rotation_mode": "in_place",
+"warn_before": null,
+"weekend_days": null`
+
+	// Version 2 added base_components and rotation_components, while
+	// track_rotation and seconds_until_rotation were added to it later.
+	stateV2 := stateV1Union + fmt.Sprintf(`,
+"base_components": {"day": 17, "hour": 10, "minute": 0, "month": 1, "second": 0, "unix": %[1]d, "year": 2030},
+"rotation_components": {"day": 16, "hour": 10, "minute": 0, "month": 2, "second": 0, "unix": %[2]d, "year": 2030}`, base.Unix(), rotation.Unix())
+
+	testCases := map[string]struct {
+		version  int64
+		state    string
+		expected map[string]tftypes.Value
+	}{
+		"v0": {
+			version: 0,
+			state:   stateV0,
+			expected: map[string]tftypes.Value{
+				"rotation_rfc3339":    tftypes.NewValue(tftypes.String, "2030-02-16T10:00:00Z"),
+				"rotation_cron":       tftypes.NewValue(tftypes.String, nil),
+				"rotation_seconds":    tftypes.NewValue(tftypes.Number, nil),
+				"timezone":            tftypes.NewValue(tftypes.String, nil),
+				"local_day":           tftypes.NewValue(tftypes.Number, 16),
+				"local_month":         tftypes.NewValue(tftypes.Number, 2),
+				"history_size":        tftypes.NewValue(tftypes.Number, nil),
+				"generation":          tftypes.NewValue(tftypes.Number, nil),
+				"track_rotation":      tftypes.NewValue(tftypes.Bool, nil),
+				"triggers":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"key1": tftypes.NewValue(tftypes.String, "value1")}),
+				"base_components":     timeRotatingComponentsValue(base),
+				"rotation_components": timeRotatingComponentsValue(rotation),
+			},
+		},
+		"v1": {
+			version: 1,
+			state:   stateV1,
+			expected: map[string]tftypes.Value{
+				"rotation_rfc3339":    tftypes.NewValue(tftypes.String, "2030-02-16T10:00:00Z"),
+				"local_day":           tftypes.NewValue(tftypes.Number, 16),
+				"history_size":        tftypes.NewValue(tftypes.Number, nil),
+				"generation":          tftypes.NewValue(tftypes.Number, nil),
+				"rotation_mode":       tftypes.NewValue(tftypes.String, nil),
+				"track_rotation":      tftypes.NewValue(tftypes.Bool, nil),
+				"base_components":     timeRotatingComponentsValue(base),
+				"rotation_components": timeRotatingComponentsValue(rotation),
+			},
+		},
+		"v1-union": {
+			version: 1,
+			state:   stateV1Union,
+			expected: map[string]tftypes.Value{
+				"rotation_rfc3339":      tftypes.NewValue(tftypes.String, "2030-02-16T10:00:00Z"),
+				"history_size":          tftypes.NewValue(tftypes.Number, 1),
+				"generation":            tftypes.NewValue(tftypes.Number, 3),
+				"rotation_mode":         tftypes.NewValue(tftypes.String, "in_place"),
+				"next_rotation_rfc3339": tftypes.NewValue(tftypes.String, "2030-03-18T10:00:00Z"),
+				"track_rotation":        tftypes.NewValue(tftypes.Bool, nil),
+				"base_components":       timeRotatingComponentsValue(base),
+				"rotation_components":   timeRotatingComponentsValue(rotation),
+			},
+		},
+		"v2": {
+			version: 2,
+			state:   stateV2,
+			expected: map[string]tftypes.Value{
+				"rotation_rfc3339":       tftypes.NewValue(tftypes.String, "2030-02-16T10:00:00Z"),
+				"history_size":           tftypes.NewValue(tftypes.Number, 1),
+				"track_rotation":         tftypes.NewValue(tftypes.Bool, nil),
+				"seconds_until_rotation": tftypes.NewValue(tftypes.Number, nil),
+				"base_components":        timeRotatingComponentsValue(base),
+				"rotation_components":    timeRotatingComponentsValue(rotation),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := upgradeRawState(t, "time_rotating", testCase.version, "{"+testCase.state+"}")
+
+			for attribute, expected := range testCase.expected {
+				if !got[attribute].Equal(expected) {
+					t.Errorf("expected %s to be %s, got: %s", attribute, expected, got[attribute])
+				}
+			}
+		})
+	}
+}
+
+// upgradeRawState upgrades a raw JSON state of the given resource type and
+// schema version with the provider server and returns the upgraded attributes.
+func upgradeRawState(t *testing.T, typeName string, version int64, rawState string) map[string]tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol5WithError(New())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov5.RawState{
+			JSON: []byte(rawState),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to upgrade state: %s", err)
+	}
+
+	for _, diagnostic := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatalf("unable to decode upgraded state: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := value.As(&attributes); err != nil {
+		t.Fatalf("unable to decode upgraded state attributes: %s", err)
+	}
+
+	return attributes
+}
+
+// timeRotatingComponentsValue returns the expected base_components or
+// rotation_components value of the given timestamp.
+func timeRotatingComponentsValue(timestamp time.Time) tftypes.Value {
+	return tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"day":    tftypes.Number,
+				"hour":   tftypes.Number,
+				"minute": tftypes.Number,
+				"month":  tftypes.Number,
+				"second": tftypes.Number,
+				"unix":   tftypes.Number,
+				"year":   tftypes.Number,
+			},
+		},
+		map[string]tftypes.Value{
+			"day":    tftypes.NewValue(tftypes.Number, timestamp.Day()),
+			"hour":   tftypes.NewValue(tftypes.Number, timestamp.Hour()),
+			"minute": tftypes.NewValue(tftypes.Number, timestamp.Minute()),
+			"month":  tftypes.NewValue(tftypes.Number, int(timestamp.Month())),
+			"second": tftypes.NewValue(tftypes.Number, timestamp.Second()),
+			"unix":   tftypes.NewValue(tftypes.Number, timestamp.Unix()),
+			"year":   tftypes.NewValue(tftypes.Number, timestamp.Year()),
+		},
+	)
+}
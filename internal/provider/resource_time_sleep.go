@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = (*timeSleepResource)(nil)
 	_ resource.ResourceWithImportState = (*timeSleepResource)(nil)
 	_ resource.ResourceWithConfigure   = (*timeSleepResource)(nil)
+	_ resource.ResourceWithIdentity    = (*timeSleepResource)(nil)
 )
 
 func NewTimeSleepResource() resource.Resource {
@@ -63,6 +65,9 @@ func (t *timeSleepResource) Configure(ctx context.Context, req resource.Configur
 
 func (t *timeSleepResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sleep"
+
+	// The identity includes arguments that can be updated in place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (t *timeSleepResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -113,9 +118,55 @@ func (t *timeSleepResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (t *timeSleepResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"create_duration": identityschema.StringAttribute{
+				Description:       "Time duration to delay resource creation, e.g. `30s`.",
+				OptionalForImport: true,
+			},
+			"destroy_duration": identityschema.StringAttribute{
+				Description:       "Time duration to delay resource destroy, e.g. `30s`.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
 func (t *timeSleepResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	id := req.ID
 
+	if id == "" && req.Identity != nil {
+		var identity timeSleepIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id = identity.CreateDuration.ValueString() + "," + identity.DestroyDuration.ValueString()
+	}
+
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if isKeyValueImportID(id) {
+		parts, keyValueTriggers, diags := keyValueImportIDParts(ctx, id, []string{"create_duration", "destroy_duration"})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if parts[0] == "" && parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), expected create_duration=CREATEDURATION, destroy_duration=DESTROYDURATION or both", id))
+
+			return
+		}
+
+		id, triggers = strings.Join(parts, ","), keyValueTriggers
+	}
+
 	idParts := strings.Split(id, ",")
 
 	if len(idParts) != 2 || (idParts[0] == "" && idParts[1] == "") {
@@ -158,10 +209,14 @@ func (t *timeSleepResource) ImportState(ctx context.Context, req resource.Import
 		state.DestroyDuration = types.StringValue(idParts[1])
 	}
 
-	state.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	state.Triggers = triggers
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeSleepIdentity(state))...)
+	}
 }
 
 func (t *timeSleepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -203,10 +258,21 @@ func (t *timeSleepResource) Create(ctx context.Context, req resource.CreateReque
 	}
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeSleepIdentity(state))...)
+	}
 }
 
 func (t *timeSleepResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state timeSleepModelV0
 
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeSleepIdentity(state))...)
 }
 
 func (t *timeSleepResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -217,6 +283,8 @@ func (t *timeSleepResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeSleepIdentity(data))...)
 }
 
 func (t *timeSleepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -257,3 +325,15 @@ type timeSleepModelV0 struct {
 	Triggers        types.Map         `tfsdk:"triggers"`
 	ID              timetypes.RFC3339 `tfsdk:"id"`
 }
+
+type timeSleepIdentityModel struct {
+	CreateDuration  types.String `tfsdk:"create_duration"`
+	DestroyDuration types.String `tfsdk:"destroy_duration"`
+}
+
+func newTimeSleepIdentity(state timeSleepModelV0) timeSleepIdentityModel {
+	return timeSleepIdentityModel{
+		CreateDuration:  state.CreateDuration,
+		DestroyDuration: state.DestroyDuration,
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hashicorp/terraform-provider-time/internal/clock"
 )
@@ -296,6 +297,57 @@ func TestAccTimeSleep_Triggers(t *testing.T) {
 	})
 }
 
+func TestAccTimeSleep_Identity(t *testing.T) {
+	resourceName := "time_sleep.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity is only available in Terraform v1.12.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeSleepCreateDurationEmptyTriggers("1ms"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						"create_duration":  knownvalue.StringExact("1ms"),
+						"destroy_duration": knownvalue.Null(),
+					}),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTimeSleep_ImportKeyValue(t *testing.T) {
+	resourceName := "time_sleep.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeSleepTriggers1("key1", "value1"),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "create_duration=1s,triggers.key1=value1",
+				// The id is the time of the import.
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id"},
+			},
+		},
+	})
+}
+
 func TestAccTimeSleep_Upgrade(t *testing.T) {
 	resourceName := "time_sleep.test"
 
@@ -390,3 +442,12 @@ resource "time_sleep" "test" {
 }
 `, keeperKey1, keeperKey2)
 }
+
+func testAccConfigTimeSleepCreateDurationEmptyTriggers(createDuration string) string {
+	return fmt.Sprintf(`
+resource "time_sleep" "test" {
+  create_duration = %[1]q
+  triggers        = {}
+}
+`, createDuration)
+}
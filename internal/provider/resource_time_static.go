@@ -10,7 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +27,8 @@ var (
 	_ resource.ResourceWithModifyPlan  = (*timeStaticResource)(nil)
 	_ resource.ResourceWithImportState = (*timeStaticResource)(nil)
 	_ resource.ResourceWithConfigure   = (*timeStaticResource)(nil)
+	_ resource.ResourceWithMoveState   = (*timeStaticResource)(nil)
+	_ resource.ResourceWithIdentity    = (*timeStaticResource)(nil)
 )
 
 func NewTimeStaticResource() resource.Resource {
@@ -100,6 +104,12 @@ func (t *timeStaticResource) ModifyPlan(ctx context.Context, req resource.Modify
 	plan.Unix = types.Int64Value(rfc3339.Unix())
 	plan.ID = plan.RFC3339
 
+	// The age is only known once applied, as it depends on the time of the
+	// apply.
+	if !plan.TrackAge.ValueBool() {
+		plan.AgeSeconds = types.Int64Null()
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
@@ -113,6 +123,11 @@ func (t *timeStaticResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"This prevents perpetual differences caused by using " +
 			"the [`timestamp()` function](https://www.terraform.io/docs/configuration/functions/timestamp.html).",
 		Attributes: map[string]schema.Attribute{
+			"age_seconds": schema.Int64Attribute{
+				Description: "Number of seconds since the `rfc3339` timestamp, refreshed on every read, or negative " +
+					"while the timestamp is in the future. Only set when `track_age` is `true`.",
+				Computed: true,
+			},
 			"day": schema.Int64Attribute{
 				Description: "Number day of timestamp.",
 				Computed:    true,
@@ -147,6 +162,7 @@ func (t *timeStaticResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -154,6 +170,11 @@ func (t *timeStaticResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Number second of timestamp.",
 				Computed:    true,
 			},
+			"track_age": schema.BoolAttribute{
+				Description: "Whether to set `age_seconds`, which is refreshed on every read. Changing this does not " +
+					"replace the resource.",
+				Optional: true,
+			},
 			"unix": schema.Int64Attribute{
 				Description: "Number of seconds since epoch time, e.g. `1581489373`.",
 				Computed:    true,
@@ -174,8 +195,52 @@ func (t *timeStaticResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (t *timeStaticResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"rfc3339": identityschema.StringAttribute{
+				Description:       "Base timestamp in RFC3339 format, e.g. `2020-02-12T06:36:13Z`.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (t *timeStaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	timestamp, err := time.Parse(time.RFC3339, req.ID)
+	id := req.ID
+
+	if id == "" && req.Identity != nil {
+		var identity timeStaticIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id = identity.RFC3339.ValueString()
+	}
+
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if isKeyValueImportID(id) {
+		parts, keyValueTriggers, diags := keyValueImportIDParts(ctx, id, []string{"base"})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if parts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Format of ID",
+				fmt.Sprintf("Unexpected format of ID (%q), expected base=BASETIMESTAMP", id))
+
+			return
+		}
+
+		id, triggers = parts[0], keyValueTriggers
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import time static error",
@@ -196,10 +261,54 @@ func (t *timeStaticResource) ImportState(ctx context.Context, req resource.Impor
 		Unix:    types.Int64Value(timestamp.Unix()),
 		ID:      timetypes.NewRFC3339TimeValue(timestamp),
 	}
-	state.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	state.Triggers = triggers
+	state.TrackAge = types.BoolNull()
+	state.AgeSeconds = types.Int64Null()
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeStaticIdentity(state))...)
+	}
+}
+
+// MoveState keeps the base timestamp and triggers of a time_offset or
+// time_rotating resource that is moved to time_static.
+func (t *timeStaticResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				timestamp, triggers, ok, diags := moveTimeSourceState(ctx, req)
+
+				resp.Diagnostics.Append(diags...)
+
+				if !ok || resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := timeStaticModelV0{
+					Year:     types.Int64Value(int64(timestamp.Year())),
+					Month:    types.Int64Value(int64(timestamp.Month())),
+					Day:      types.Int64Value(int64(timestamp.Day())),
+					Hour:     types.Int64Value(int64(timestamp.Hour())),
+					Minute:   types.Int64Value(int64(timestamp.Minute())),
+					Second:   types.Int64Value(int64(timestamp.Second())),
+					RFC3339:  timetypes.NewRFC3339TimeValue(timestamp),
+					Triggers: triggers,
+					Unix:     types.Int64Value(timestamp.Unix()),
+					ID:       timetypes.NewRFC3339TimeValue(timestamp),
+					TrackAge: types.BoolNull(),
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, newTimeStaticIdentity(state))...)
+				}
+			},
+		},
+	}
 }
 
 func (t *timeStaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -236,24 +345,78 @@ func (t *timeStaticResource) Create(ctx context.Context, req resource.CreateRequ
 		RFC3339:  timetypes.NewRFC3339TimeValue(timestamp),
 		Unix:     types.Int64Value(timestamp.Unix()),
 		ID:       timetypes.NewRFC3339TimeValue(timestamp),
+		TrackAge: plan.TrackAge,
+	}
+
+	resp.Diagnostics.Append(setStaticAge(&state, t.clock.Now())...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newTimeStaticIdentity(state))...)
+	}
 }
 
 func (t *timeStaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state timeStaticModelV0
 
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TrackAge.ValueBool() {
+		resp.Diagnostics.Append(setStaticAge(&state, t.clock.Now())...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeStaticIdentity(state))...)
 }
 
 func (t *timeStaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data timeStaticModelV0
+	var data, state timeStaticModelV0
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only track_age can be updated in place, so the timestamp values are kept
+	// from the prior state.
+	data.Year = state.Year
+	data.Month = state.Month
+	data.Day = state.Day
+	data.Hour = state.Hour
+	data.Minute = state.Minute
+	data.Second = state.Second
+	data.Unix = state.Unix
+
+	resp.Diagnostics.Append(setStaticAge(&data, t.clock.Now())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newTimeStaticIdentity(data))...)
 }
 
 func (t *timeStaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -261,14 +424,46 @@ func (t *timeStaticResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 type timeStaticModelV0 struct {
-	Day      types.Int64       `tfsdk:"day"`
-	Hour     types.Int64       `tfsdk:"hour"`
-	Triggers types.Map         `tfsdk:"triggers"`
-	Minute   types.Int64       `tfsdk:"minute"`
-	Month    types.Int64       `tfsdk:"month"`
-	RFC3339  timetypes.RFC3339 `tfsdk:"rfc3339"`
-	Second   types.Int64       `tfsdk:"second"`
-	Unix     types.Int64       `tfsdk:"unix"`
-	Year     types.Int64       `tfsdk:"year"`
-	ID       timetypes.RFC3339 `tfsdk:"id"`
+	AgeSeconds types.Int64       `tfsdk:"age_seconds"`
+	Day        types.Int64       `tfsdk:"day"`
+	Hour       types.Int64       `tfsdk:"hour"`
+	Triggers   types.Map         `tfsdk:"triggers"`
+	Minute     types.Int64       `tfsdk:"minute"`
+	Month      types.Int64       `tfsdk:"month"`
+	RFC3339    timetypes.RFC3339 `tfsdk:"rfc3339"`
+	Second     types.Int64       `tfsdk:"second"`
+	TrackAge   types.Bool        `tfsdk:"track_age"`
+	Unix       types.Int64       `tfsdk:"unix"`
+	Year       types.Int64       `tfsdk:"year"`
+	ID         timetypes.RFC3339 `tfsdk:"id"`
+}
+
+// setStaticAge sets age_seconds from the rfc3339 timestamp if track_age is
+// enabled.
+func setStaticAge(state *timeStaticModelV0, now time.Time) diag.Diagnostics {
+	state.AgeSeconds = types.Int64Null()
+
+	if !state.TrackAge.ValueBool() || state.RFC3339.ValueString() == "" {
+		return nil
+	}
+
+	timestamp, diags := state.RFC3339.ValueRFC3339Time()
+
+	if diags.HasError() {
+		return diags
+	}
+
+	state.AgeSeconds = types.Int64Value(int64(now.Sub(timestamp) / time.Second))
+
+	return diags
+}
+
+type timeStaticIdentityModel struct {
+	RFC3339 types.String `tfsdk:"rfc3339"`
+}
+
+func newTimeStaticIdentity(state timeStaticModelV0) timeStaticIdentityModel {
+	return timeStaticIdentityModel{
+		RFC3339: types.StringValue(state.RFC3339.ValueString()),
+	}
 }
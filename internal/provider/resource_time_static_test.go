@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-time/internal/timetesting"
 )
 
@@ -145,6 +147,237 @@ func TestAccTimeStatic_Rfc3339(t *testing.T) {
 	})
 }
 
+func TestAccTimeStatic_Identity(t *testing.T) {
+	resourceName := "time_static.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity is only available in Terraform v1.12.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticEmptyTriggers(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesStateAtPath(resourceName, tfjsonpath.New("rfc3339"), tfjsonpath.New("rfc3339")),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTimeStatic_ImportKeyValue(t *testing.T) {
+	resourceName := "time_static.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticTriggers1("key1", "value1"),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("base=%s,triggers.key1=value1", rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimeStatic_ImportKeyValueTriggersComma(t *testing.T) {
+	resourceName := "time_static.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticTriggers1("key1", "value1,value2"),
+			},
+			// A part without a key continues the previous triggers value.
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("base=%s,triggers.key1=value1,value2", rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "base=2024-01-01T00:00:00Z,value2",
+				ExpectError:   regexp.MustCompile(`the base value cannot contain commas`),
+			},
+		},
+	})
+}
+
+func TestAccTimeStatic_TrackAge(t *testing.T) {
+	resourceName := "time_static.test"
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			// The age is negative while the configured timestamp is in the future.
+			{
+				Config: testAccConfigTimeStaticRfc3339TrackAge("2030-01-18T10:00:00Z", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(-24*60*60)),
+				},
+			},
+			{
+				PreConfig: func() {
+					mockClock.IncrementDate(0, 0, 2)
+				},
+				Config: testAccConfigTimeStaticRfc3339TrackAge("2030-01-18T10:00:00Z", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(24*60*60)),
+				},
+			},
+			// Disabling track_age keeps the timestamp instead of replacing the resource.
+			{
+				Config: testAccConfigTimeStaticRfc3339TrackAge("2030-01-18T10:00:00Z", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-18T10:00:00Z")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeStatic_TrackAgeUpdate(t *testing.T) {
+	resourceName := "time_static.test"
+	assertRfc3339Same := statecheck.CompareValue(compare.ValuesSame())
+	assertRfc3339Updated := statecheck.CompareValue(compare.ValuesDiffer())
+
+	mockClock := timetesting.NewFakeClock(time.Date(2030, time.January, 17, 10, 0, 0, 0, time.UTC))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesTestProvider(mockClock),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeStaticTriggersTrackAge("key1", "value1", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertRfc3339Same.AddStateValue(resourceName, tfjsonpath.New("rfc3339")),
+					assertRfc3339Updated.AddStateValue(resourceName, tfjsonpath.New("rfc3339")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(0)),
+				},
+			},
+			// Changing track_age without a configured rfc3339 keeps the timestamp.
+			{
+				PreConfig: func() {
+					mockClock.Increment(time.Hour)
+				},
+				Config: testAccConfigTimeStaticTriggersTrackAge("key1", "value1", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact("2030-01-17T10:00:00Z")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertRfc3339Same.AddStateValue(resourceName, tfjsonpath.New("rfc3339")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hour"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccConfigTimeStaticTriggersTrackAge("key1", "value1", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertRfc3339Same.AddStateValue(resourceName, tfjsonpath.New("rfc3339")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(60*60)),
+				},
+			},
+			// Changing the triggers still replaces the resource.
+			{
+				Config: testAccConfigTimeStaticTriggersTrackAge("key1", "value1updated", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertRfc3339Updated.AddStateValue(resourceName, tfjsonpath.New("rfc3339")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("age_seconds"), knownvalue.Int64Exact(0)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimeStatic_MoveStateFromTimeOffset(t *testing.T) {
+	resourceName := "time_static.test"
+	timestamp := time.Now().UTC()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Moving state between resource types is only available in Terraform v1.8.0 and above.
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTimeOffsetOffsetDays(timestamp.Format(time.RFC3339), 7),
+			},
+			{
+				Config: testAccConfigTimeStaticMovedFromTimeOffset(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rfc3339"), knownvalue.StringExact(timestamp.Format(time.RFC3339))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("unix"), knownvalue.Int64Exact(timestamp.Unix())),
+				},
+			},
+		},
+	})
+}
+
 func TestAccTimeStatic_Upgrade(t *testing.T) {
 	resourceName := "time_static.test"
 
@@ -226,3 +459,42 @@ resource "time_static" "test" {
 }
 `, rfc3339)
 }
+
+func testAccConfigTimeStaticMovedFromTimeOffset() string {
+	return `
+moved {
+  from = time_offset.test
+  to   = time_static.test
+}
+
+resource "time_static" "test" {}
+`
+}
+
+func testAccConfigTimeStaticEmptyTriggers() string {
+	return `
+resource "time_static" "test" {
+  triggers = {}
+}
+`
+}
+
+func testAccConfigTimeStaticRfc3339TrackAge(rfc3339 string, trackAge bool) string {
+	return fmt.Sprintf(`
+resource "time_static" "test" {
+  rfc3339   = %[1]q
+  track_age = %[2]t
+}
+`, rfc3339, trackAge)
+}
+
+func testAccConfigTimeStaticTriggersTrackAge(keeperKey1 string, keeperKey2 string, trackAge bool) string {
+	return fmt.Sprintf(`
+resource "time_static" "test" {
+  triggers = {
+    %[1]q = %[2]q
+  }
+  track_age = %[3]t
+}
+`, keeperKey1, keeperKey2, trackAge)
+}
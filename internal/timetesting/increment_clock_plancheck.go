@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetesting
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = incrementClock{}
+
+type incrementClock struct {
+	clock    *FakeClock
+	duration time.Duration
+}
+
+// CheckPlan increments the clock without checking the plan, so that the apply
+// happens at a later time than the plan.
+func (c incrementClock) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	c.clock.Increment(c.duration)
+}
+
+// IncrementClock returns a plan check that increments the given clock by the
+// given duration between the plan and the apply.
+func IncrementClock(clock *FakeClock, duration time.Duration) plancheck.PlanCheck {
+	return incrementClock{
+		clock:    clock,
+		duration: duration,
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
+)
+
+var _ validator.String = boundaryValidator{}
+
+type boundaryValidator struct{}
+
+func (v boundaryValidator) Description(_ context.Context) string {
+	return "value must be a calendar unit, e.g. hour, or a positive Go or ISO 8601 duration of at most 24h, e.g. 15m"
+}
+
+func (v boundaryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v boundaryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := calendar.ParseBoundary(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Boundary",
+			fmt.Sprintf("Attribute %s %s, got: %q\n\nOriginal Error: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// Boundary returns a validator which ensures that any configured string value
+// is a calendar unit or duration accepted by calendar.ParseBoundary.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Boundary() validator.String {
+	return boundaryValidator{}
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBoundaryValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"minute": {
+			value: types.StringValue("minute"),
+		},
+		"hour": {
+			value: types.StringValue("hour"),
+		},
+		"day": {
+			value: types.StringValue("day"),
+		},
+		"week": {
+			value: types.StringValue("week"),
+		},
+		"month": {
+			value: types.StringValue("month"),
+		},
+		"quarter": {
+			value: types.StringValue("quarter"),
+		},
+		"year": {
+			value: types.StringValue("year"),
+		},
+		"unit-case-insensitive": {
+			value: types.StringValue("HOUR"),
+		},
+		"go-duration": {
+			value: types.StringValue("15m"),
+		},
+		"iso-duration": {
+			value: types.StringValue("PT6H"),
+		},
+		"day-duration": {
+			value: types.StringValue("24h"),
+		},
+		"unknown-unit": {
+			value:       types.StringValue("fortnight"),
+			expectError: true,
+		},
+		"zero-duration": {
+			value:       types.StringValue("0s"),
+			expectError: true,
+		},
+		"negative-duration": {
+			value:       types.StringValue("-15m"),
+			expectError: true,
+		},
+		"longer-than-a-day": {
+			value:       types.StringValue("25h"),
+			expectError: true,
+		},
+		"calendar-duration": {
+			value:       types.StringValue("P1D"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			Boundary().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
+)
+
+var _ validator.String = cronValidator{}
+
+type cronValidator struct{}
+
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a five field cron expression, optionally prefixed with CRON_TZ=<time zone>"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := calendar.ParseCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("Attribute %s %s, got: %q\n\nOriginal Error: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// Cron returns a validator which ensures that any configured string value is
+// a cron expression accepted by calendar.ParseCron.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Cron() validator.String {
+	return cronValidator{}
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"daily": {
+			value: types.StringValue("0 3 * * *"),
+		},
+		"lists-ranges-and-steps": {
+			value: types.StringValue("*/15 8-18 * 1,4,7,10 MON-FRI"),
+		},
+		"macro": {
+			value: types.StringValue("@weekly"),
+		},
+		"timezone-prefix": {
+			value: types.StringValue("CRON_TZ=Europe/Berlin 0 3 * * *"),
+		},
+		"timezone-prefix-macro": {
+			value: types.StringValue("CRON_TZ=America/New_York @daily"),
+		},
+		"empty": {
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		"four-fields": {
+			value:       types.StringValue("0 3 * *"),
+			expectError: true,
+		},
+		"six-fields": {
+			value:       types.StringValue("0 0 3 * * *"),
+			expectError: true,
+		},
+		"out-of-range": {
+			value:       types.StringValue("0 24 * * *"),
+			expectError: true,
+		},
+		"invalid-timezone-prefix": {
+			value:       types.StringValue("CRON_TZ=Mars/Olympus_Mons 0 3 * * *"),
+			expectError: true,
+		},
+		"only-timezone-prefix": {
+			value:       types.StringValue("CRON_TZ=UTC"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			Cron().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
+)
+
+var _ validator.String = dateValidator{}
+
+type dateValidator struct{}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "value must be a date in YYYY-MM-DD format, e.g. 2030-12-25"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := calendar.ParseDate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// Date returns a validator which ensures that any configured string value
+// is a date accepted by calendar.ParseDate.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Date() validator.String {
+	return dateValidator{}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDateValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"date": {
+			value: types.StringValue("2030-12-25"),
+		},
+		"leap-day": {
+			value: types.StringValue("2028-02-29"),
+		},
+		"timestamp": {
+			value:       types.StringValue("2030-12-25T00:00:00Z"),
+			expectError: true,
+		},
+		"day-first": {
+			value:       types.StringValue("25-12-2030"),
+			expectError: true,
+		},
+		"without-leading-zeros": {
+			value:       types.StringValue("2030-1-5"),
+			expectError: true,
+		},
+		"impossible-date": {
+			value:       types.StringValue("2030-02-30"),
+			expectError: true,
+		},
+		"non-leap-year": {
+			value:       types.StringValue("2030-02-29"),
+			expectError: true,
+		},
+		"invalid-month": {
+			value:       types.StringValue("2030-13-01"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			Date().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
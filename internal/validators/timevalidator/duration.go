@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
+)
+
+var _ validator.String = durationValidator{}
+
+type durationValidator struct {
+	positive bool
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	if v.positive {
+		return "value must be a positive Go duration, e.g. 36h, or ISO 8601 duration, e.g. P1M2DT3H"
+	}
+
+	return "value must be a Go duration, e.g. 36h, or ISO 8601 duration, e.g. P1M2DT3H"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	period, err := calendar.ParsePeriod(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %q\n\nOriginal Error: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+
+	if v.positive && (period.IsZero() || period.IsNegative()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// PositiveDuration returns a validator which ensures that any configured
+// string value is a Go or ISO 8601 duration accepted by calendar.ParsePeriod
+// that moves timestamps forwards.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func PositiveDuration() validator.String {
+	return durationValidator{positive: true}
+}
+
+// Duration returns a validator which ensures that any configured string value
+// is a Go or ISO 8601 duration accepted by calendar.ParsePeriod. Negative and
+// zero durations are allowed.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return durationValidator{}
+}
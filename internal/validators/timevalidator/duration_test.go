@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator   validator.String
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			validator: Duration(),
+			value:     types.StringNull(),
+		},
+		"unknown": {
+			validator: Duration(),
+			value:     types.StringUnknown(),
+		},
+		"go-duration": {
+			validator: Duration(),
+			value:     types.StringValue("36h"),
+		},
+		"negative-go-duration": {
+			validator: Duration(),
+			value:     types.StringValue("-90m"),
+		},
+		"iso-duration": {
+			validator: Duration(),
+			value:     types.StringValue("P1Y2M10DT2H30M"),
+		},
+		"negative-iso-duration": {
+			validator: Duration(),
+			value:     types.StringValue("-P1D"),
+		},
+		"zero": {
+			validator: Duration(),
+			value:     types.StringValue("0s"),
+		},
+		"invalid": {
+			validator:   Duration(),
+			value:       types.StringValue("1 day"),
+			expectError: true,
+		},
+		"positive-go-duration": {
+			validator: PositiveDuration(),
+			value:     types.StringValue("36h"),
+		},
+		"positive-negative": {
+			validator:   PositiveDuration(),
+			value:       types.StringValue("-90m"),
+			expectError: true,
+		},
+		"positive-zero": {
+			validator:   PositiveDuration(),
+			value:       types.StringValue("PT0S"),
+			expectError: true,
+		},
+		"positive-invalid": {
+			validator:   PositiveDuration(),
+			value:       types.StringValue("1 day"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			testCase.validator.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timezoneValidator{}
+
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name, e.g. Europe/Berlin"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// time.LoadLocation returns the time zone of the machine for Local, which
+	// would make the results depend on where Terraform runs.
+	if req.ConfigValue.ValueString() == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("Attribute %s %s, got: %q\n\nThe time zone of the machine running Terraform is not supported.", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+
+		return
+	}
+
+	if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("Attribute %s %s, got: %q\n\nOriginal Error: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// Timezone returns a validator which ensures that any configured string value
+// is a time zone that can be loaded with time.LoadLocation, other than Local.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Timezone() validator.String {
+	return timezoneValidator{}
+}
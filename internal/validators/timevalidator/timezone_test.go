@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimezoneValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"iana-name": {
+			value: types.StringValue("Europe/Berlin"),
+		},
+		"utc": {
+			value: types.StringValue("UTC"),
+		},
+		"local": {
+			value:       types.StringValue("Local"),
+			expectError: true,
+		},
+		"abbreviation": {
+			value:       types.StringValue("CEST"),
+			expectError: true,
+		},
+		"unknown-name": {
+			value:       types.StringValue("Mars/Olympus_Mons"),
+			expectError: true,
+		},
+		"garbage": {
+			value:       types.StringValue("not a time zone"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			Timezone().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-time/internal/calendar"
+)
+
+var _ validator.String = weekdayValidator{}
+
+type weekdayValidator struct{}
+
+func (v weekdayValidator) Description(_ context.Context) string {
+	return "value must be an English weekday name, e.g. MONDAY, or its three letter abbreviation, e.g. MON"
+}
+
+func (v weekdayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v weekdayValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := calendar.ParseWeekday(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Weekday",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// Weekday returns a validator which ensures that any configured string value
+// is a weekday accepted by calendar.ParseWeekday.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Weekday() validator.String {
+	return weekdayValidator{}
+}
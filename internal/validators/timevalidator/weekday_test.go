@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timevalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWeekdayValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"name": {
+			value: types.StringValue("MONDAY"),
+		},
+		"abbreviation": {
+			value: types.StringValue("SUN"),
+		},
+		"case-insensitive": {
+			value: types.StringValue("Friday"),
+		},
+		"unknown-name": {
+			value:       types.StringValue("CAKEDAY"),
+			expectError: true,
+		},
+		"two-letters": {
+			value:       types.StringValue("MO"),
+			expectError: true,
+		},
+		"number": {
+			value:       types.StringValue("1"),
+			expectError: true,
+		},
+		"empty": {
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			Weekday().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}